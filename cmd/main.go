@@ -1,11 +1,17 @@
 package main
 
 import (
+	"log"
+	"os"
+
 	"log_records_linter/pkg"
 
 	"golang.org/x/tools/go/analysis/singlechecker"
 )
 
 func main() {
+	if err := pkg.RequireUniqueMessages(os.Args[1:]); err != nil {
+		log.Fatal(err)
+	}
 	singlechecker.Main(pkg.LogsAnalyzer)
 }
@@ -0,0 +1,93 @@
+package pkg
+
+// ahoCorasick is an Aho-Corasick automaton finding all occurrences of a set of patterns in a single
+// pass over a string. ASCII letters are matched case-insensitively.
+type ahoCorasick struct {
+	// next is the complete transition table, indexed by state and lowercased input byte.
+	next [][256]int32
+	// outputs lists the patterns ending in each state, including those reached through suffix links.
+	outputs [][]int
+	// lengths holds the byte length of each pattern.
+	lengths []int
+}
+
+// acMatch is an occurrence of a pattern of an ahoCorasick automaton.
+type acMatch struct {
+	pattern    int
+	start, end int
+}
+
+// newAhoCorasick builds the automaton of the patterns; matches report patterns by their index.
+func newAhoCorasick(patterns []string) *ahoCorasick {
+	ac := &ahoCorasick{next: make([][256]int32, 1), outputs: make([][]int, 1), lengths: make([]int, len(patterns))}
+
+	for i, pattern := range patterns {
+		ac.lengths[i] = len(pattern)
+		state := int32(0)
+		for j := 0; j < len(pattern); j++ {
+			c := lowerASCII(pattern[j])
+			if ac.next[state][c] == 0 {
+				ac.next = append(ac.next, [256]int32{})
+				ac.outputs = append(ac.outputs, nil)
+				ac.next[state][c] = int32(len(ac.next) - 1)
+			}
+			state = ac.next[state][c]
+		}
+		ac.outputs[state] = append(ac.outputs[state], i)
+	}
+
+	// Complete the transitions breadth-first, so that missing ones follow the suffix links.
+	fail := make([]int32, len(ac.next))
+	var queue []int32
+	for c := range 256 {
+		if s := ac.next[0][c]; s != 0 {
+			queue = append(queue, s)
+		}
+	}
+	for len(queue) > 0 {
+		state := queue[0]
+		queue = queue[1:]
+		ac.outputs[state] = append(ac.outputs[state], ac.outputs[fail[state]]...)
+		for c := range 256 {
+			s := ac.next[state][c]
+			if s == 0 {
+				ac.next[state][c] = ac.next[fail[state]][c]
+				continue
+			}
+			fail[s] = ac.next[fail[state]][c]
+			queue = append(queue, s)
+		}
+	}
+	return ac
+}
+
+// findAll calls found for every occurrence of a pattern in s, in order of their end offsets.
+func (ac *ahoCorasick) findAll(s string, found func(acMatch)) {
+	state := int32(0)
+	for i := 0; i < len(s); i++ {
+		state = ac.next[state][lowerASCII(s[i])]
+		for _, pattern := range ac.outputs[state] {
+			found(acMatch{pattern: pattern, start: i + 1 - ac.lengths[pattern], end: i + 1})
+		}
+	}
+}
+
+// contains reports whether any pattern occurs in s, stopping at the first occurrence.
+func (ac *ahoCorasick) contains(s string) bool {
+	state := int32(0)
+	for i := 0; i < len(s); i++ {
+		state = ac.next[state][lowerASCII(s[i])]
+		if len(ac.outputs[state]) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// lowerASCII lowercases an ASCII letter and returns other bytes unchanged.
+func lowerASCII(c byte) byte {
+	if 'A' <= c && c <= 'Z' {
+		return c + 'a' - 'A'
+	}
+	return c
+}
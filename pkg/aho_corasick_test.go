@@ -0,0 +1,53 @@
+package pkg
+
+import (
+	"slices"
+	"testing"
+)
+
+// TestAhoCorasick tests the ahoCorasick automaton
+// to ensure overlapping and nested occurrences are all found, ignoring ASCII case
+func TestAhoCorasick(t *testing.T) {
+	ac := newAhoCorasick([]string{"he", "she", "his", "hers", "key"})
+
+	tests := []struct {
+		name string
+		text string
+		want []acMatch
+	}{
+		{
+			name: "overlapping",
+			text: "ushers",
+			want: []acMatch{{pattern: 1, start: 1, end: 4}, {pattern: 0, start: 2, end: 4}, {pattern: 3, start: 2, end: 6}},
+		},
+		{
+			name: "case_insensitive",
+			text: "API KEY",
+			want: []acMatch{{pattern: 4, start: 4, end: 7}},
+		},
+		{
+			name: "repeated",
+			text: "hishe",
+			want: []acMatch{{pattern: 2, start: 0, end: 3}, {pattern: 1, start: 2, end: 5}, {pattern: 0, start: 3, end: 5}},
+		},
+		{
+			name: "none",
+			text: "token",
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var got []acMatch
+			ac.findAll(tt.text, func(m acMatch) {
+				got = append(got, m)
+			})
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("expected %v, got %v: %s", tt.want, got, tt.name)
+			}
+		})
+	}
+}
@@ -4,7 +4,9 @@ import (
 	"go/ast"
 	"go/token"
 	"go/types"
+	"slices"
 	"strconv"
+	"strings"
 
 	"github.com/golangci/plugin-module-register/register"
 	"golang.org/x/tools/go/analysis"
@@ -43,16 +45,62 @@ type PluginExample struct {
 // BuildAnalyzers builds the analyzers for the plugin based on the provided settings.
 func (f *PluginExample) BuildAnalyzers() ([]*analysis.Analyzer, error) {
 	settings := f.settings
-	return []*analysis.Analyzer{
-		{
-			Name: "logs",
-			Doc:  "Checks log records correct formatting",
+	cfg, err := ResolveConfig(settings.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	analyzer := &analysis.Analyzer{
+		Name: "logs",
+		Doc:  "Checks log records correct formatting",
+		Run: func(pass *analysis.Pass) (interface{}, error) {
+			return runWithSettings(pass, settings)
+		},
+		Requires: []*analysis.Analyzer{inspect.Analyzer},
+	}
+	if cfg.EnableUniqueMessages {
+		analyzer.Requires = append(analyzer.Requires, &analysis.Analyzer{
+			Name: UniqueMessagesAnalyzer.Name,
+			Doc:  UniqueMessagesAnalyzer.Doc,
 			Run: func(pass *analysis.Pass) (interface{}, error) {
-				return runWithSettings(pass, settings)
+				return runUniqueMessages(pass, settings)
 			},
-			Requires: []*analysis.Analyzer{inspect.Analyzer},
-		},
-	}, nil
+			Requires:   UniqueMessagesAnalyzer.Requires,
+			FactTypes:  UniqueMessagesAnalyzer.FactTypes,
+			ResultType: UniqueMessagesAnalyzer.ResultType,
+		})
+	}
+	return []*analysis.Analyzer{analyzer}, nil
+}
+
+// RequireUniqueMessages makes LogsAnalyzer require UniqueMessagesAnalyzer if the config given by
+// the -config flag in args enables unique messages. It must be called before the driver runs.
+func RequireUniqueMessages(args []string) error {
+	cfg, err := ResolveConfig(configFlagValue(args))
+	if err != nil {
+		return err
+	}
+	if cfg.EnableUniqueMessages && !slices.Contains(LogsAnalyzer.Requires, UniqueMessagesAnalyzer) {
+		LogsAnalyzer.Requires = append(LogsAnalyzer.Requires, UniqueMessagesAnalyzer)
+	}
+	return nil
+}
+
+// configFlagValue returns the value of the -config flag in the command-line arguments.
+func configFlagValue(args []string) string {
+	for i, arg := range args {
+		name, value, hasValue := strings.Cut(strings.TrimLeft(arg, "-"), "=")
+		if !strings.HasPrefix(arg, "-") || name != "config" {
+			continue
+		}
+		if hasValue {
+			return value
+		}
+		if i+1 < len(args) {
+			return args[i+1]
+		}
+	}
+	return ""
 }
 
 // GetLoadMode specifies that the plugin should be loaded in "info" mode,
@@ -90,17 +138,93 @@ func runWithSettings(pass *analysis.Pass, settings MySettings) (interface{}, err
 		(*ast.CallExpr)(nil),
 	}
 
-	insp.Preorder(nodeFilter, func(n ast.Node) {
+	insp.WithStack(nodeFilter, func(n ast.Node, push bool, stack []ast.Node) bool {
+		if !push {
+			return true
+		}
+
 		callExpr := n.(*ast.CallExpr)
-		if !isLogCall(pass, callExpr) {
-			return
+		if cfg.EnableNoGlobalLogger {
+			checkGlobalLoggerAccessor(pass, callExpr, cfg.GlobalLoggerAllowedPackages)
 		}
 
-		msg, msgPos := extractMessage(callExpr)
+		lc, ok := parseLogCall(pass, callExpr)
+		if !ok {
+			if fmtIndex, name, declared := printfLoggerFormatIndex(pass, callExpr, cfg.PrintfLoggers); declared {
+				if cfg.EnablePrintfCheck {
+					checkPrintf(pass, callExpr, fmtIndex, name)
+				}
+				if cfg.EnableSensitivePatterns {
+					checkSensitivePrintfArgs(pass, callExpr, fmtIndex, cfg.sensitive, cfg.redaction, cfg.SensitiveKeywordMode == keywordModeValue)
+				}
+			}
+			return true
+		}
+
+		if cfg.EnableFormatVerbs {
+			checkFormatVerbs(pass, lc)
+		}
+		if cfg.EnableErrorAttr {
+			checkErrorAttr(pass, lc, stack)
+		}
+		if cfg.EnableNoErrorString {
+			checkNoErrorString(pass, lc)
+		}
+		if cfg.EnableLogAndReturn {
+			checkLogAndReturn(pass, lc, stack, cfg.LogAndReturnAllowedFuncs)
+		}
+		if cfg.EnableFatalOutsideMain {
+			checkFatalOutsideMain(pass, lc, cfg.FatalAllowedPackages)
+		}
+		if cfg.EnableContextLogging {
+			checkContextLogging(pass, lc)
+		}
+		if cfg.EnableNoGlobalLogger {
+			checkGlobalLogger(pass, lc, cfg.GlobalLoggerAllowedPackages)
+		}
+		if cfg.EnableLoopLogging {
+			checkLoopLogging(pass, lc, stack, cfg.loopLogMinLevel, cfg.LoopLogSamplingFuncs)
+		}
+		if cfg.EnableExpensiveDebugArgs {
+			checkExpensiveDebugArgs(pass, lc, stack, cfg.ExpensiveFuncs)
+		}
+		if cfg.EnableRequiredFields {
+			checkRequiredFields(pass, lc, stack, cfg.RequiredFields)
+		}
+		if cfg.EnableBannedPhrases {
+			checkBannedPhrases(pass, lc, cfg.bannedPhrases)
+		}
+		if cfg.EnableSpellCheck {
+			checkSpelling(pass, lc, cfg.spellChecker)
+		}
+		if cfg.EnableMessagePatterns {
+			checkMessagePatterns(pass, lc, cfg.messagePatterns)
+		}
+		if cfg.EnableSensitivePatterns {
+			checkSensitiveValues(pass, lc, cfg.sensitive)
+			if cfg.SensitiveKeywordMode == keywordModeValue {
+				checkSensitiveKeywordValues(pass, lc, cfg.sensitive, cfg.redaction)
+			}
+		}
+
+		if lc.kind == kindPrintf {
+			if cfg.EnablePrintfCheck {
+				checkPrintf(pass, callExpr, lc.msgIndex, lc.sel.Sel.Name)
+			}
+			if cfg.EnableSensitivePatterns {
+				checkSensitivePrintfArgs(pass, callExpr, lc.msgIndex, cfg.sensitive, cfg.redaction, cfg.SensitiveKeywordMode == keywordModeValue)
+			}
+			return true
+		}
+
+		msg, msgPos := extractMessage(lc)
 		if msg == "" {
-			return
+			return true
 		}
 
+		if !lc.baselineScope() {
+			return true
+		}
 		if cfg.EnableLowercaseStart {
 			checkLowercaseStart(pass, msgPos, msg)
 		}
@@ -108,23 +232,28 @@ func runWithSettings(pass *analysis.Pass, settings MySettings) (interface{}, err
 			checkNoSpecialChars(pass, msgPos, msg)
 		}
 		if cfg.EnableSensitivePatterns {
-			checkNoSensitiveData(pass, msgPos, msg)
+			checkNoSensitiveData(pass, lc, cfg.sensitive, cfg.SensitiveKeywordMode == keywordModeMention)
 		}
 		if cfg.EnableEnglishOnly {
 			checkEnglishOnly(pass, msgPos, msg)
 		}
+		return true
 	})
 
+	for _, result := range pass.ResultOf {
+		if diags, ok := result.(uniqueMessagesResult); ok {
+			for _, diag := range diags {
+				pass.Report(diag)
+			}
+		}
+	}
+
 	return nil, nil
 }
 
-// extractMessage attempts to extract the log message from the first argument of the log call.
-func extractMessage(call *ast.CallExpr) (string, token.Pos) {
-	if len(call.Args) == 0 {
-		return "", token.NoPos
-	}
-
-	lit, ok := call.Args[0].(*ast.BasicLit)
+// extractMessage attempts to extract the log message from the message argument of the log call.
+func extractMessage(lc *logCall) (string, token.Pos) {
+	lit, ok := lc.message().(*ast.BasicLit)
 	if !ok || lit.Kind != token.STRING {
 		return "", token.NoPos
 	}
@@ -137,82 +266,67 @@ func extractMessage(call *ast.CallExpr) (string, token.Pos) {
 	return msg, lit.Pos()
 }
 
-// isLogCall checks if the given call expression is a log call by examining the function being called and its type information.
-func isLogCall(pass *analysis.Pass, callExpr *ast.CallExpr) bool {
-	selectorExpr, ok := callExpr.Fun.(*ast.SelectorExpr)
-	if !ok {
-		return false
-	}
-
-	if !isLogLevel(selectorExpr) {
-		return false
-	}
-
-	if isStdLoggerSelector(pass, selectorExpr) {
-		return true
-	}
-
-	if isLoggerType(pass, selectorExpr.X) {
-		return true
-	}
-
-	return false
-}
-
-// isLogLevel checks if the selector expression corresponds
-// to a common log level method (e.g., Debug, Info, Warn, Error, Fatal, Panic).
-func isLogLevel(selectorExpr *ast.SelectorExpr) bool {
-	switch selectorExpr.Sel.Name {
-	case "Debug", "Info", "Warn", "Error", "Fatal", "Panic":
-		return true
-	}
-	return false
+// isStdLoggerSelector checks if the selector expression is a package-level function
+// of a logging package (e.g., log or log/slog) rather than a method of a logger value.
+func isStdLoggerSelector(pass *analysis.Pass, selectorExpr *ast.SelectorExpr) bool {
+	return libraryByPackage(selectorPackagePath(pass, selectorExpr)) != libUnknown
 }
 
-// isStdLoggerSelector checks if the selector expression is a standard library logger (e.g., log or log/slog).
-func isStdLoggerSelector(pass *analysis.Pass, selectorExpr *ast.SelectorExpr) bool {
+// selectorPackagePath returns the import path of the package the selector is qualified with,
+// or an empty string if the selector is not a qualified identifier.
+func selectorPackagePath(pass *analysis.Pass, selectorExpr *ast.SelectorExpr) string {
 	if pass == nil || pass.TypesInfo == nil {
-		return false
+		return ""
 	}
 
 	ident, ok := selectorExpr.X.(*ast.Ident)
 	if !ok {
-		return false
+		return ""
 	}
 
 	obj, exists := pass.TypesInfo.Uses[ident]
 	if !exists || obj == nil {
-		return false
+		return ""
 	}
 
 	pkgName, ok := obj.(*types.PkgName)
 	if !ok || pkgName == nil {
-		return false
+		return ""
 	}
 
 	imported := pkgName.Imported()
 	if imported == nil {
-		return false
+		return ""
 	}
+	return imported.Path()
+}
 
-	switch imported.Path() {
-	case "log", "log/slog":
-		return true
+// libraryByPackage maps the import path of a package with package-level logging functions to its library.
+func libraryByPackage(path string) logLibrary {
+	switch path {
+	case "log":
+		return libStdLog
+	case "log/slog":
+		return libSlog
+	case "github.com/sirupsen/logrus":
+		return libLogrus
+	case "k8s.io/klog/v2", "k8s.io/klog":
+		return libKlog
 	default:
-		return false
+		return libUnknown
 	}
 }
 
-// isLoggerType checks if the given expression corresponds to
-// a logger type from supported logging libraries (e.g., zap.Logger, zap.SugaredLogger, slog.Logger).
-func isLoggerType(pass *analysis.Pass, expr ast.Expr) bool {
+// loggerTypeLibrary checks if the given expression corresponds to a logger type from supported
+// logging libraries (e.g., zap.Logger, zap.SugaredLogger, slog.Logger) and returns its library.
+func loggerTypeLibrary(pass *analysis.Pass, expr ast.Expr) logLibrary {
 	if pass == nil || pass.TypesInfo == nil || expr == nil {
-		return false
+		return libUnknown
 	}
 
 	loggerType := pass.TypesInfo.TypeOf(expr)
 	if loggerType == nil {
-		return false
+		return libUnknown
 	}
 
 	if ptr, ok := loggerType.(*types.Pointer); ok {
@@ -221,18 +335,26 @@ func isLoggerType(pass *analysis.Pass, expr ast.Expr) bool {
 
 	named, ok := loggerType.(*types.Named)
 	if !ok || named == nil || named.Obj() == nil || named.Obj().Pkg() == nil {
-		return false
+		return libUnknown
 	}
 
 	pkgPath := named.Obj().Pkg().Path()
 	name := named.Obj().Name()
 
-	switch pkgPath {
-	case "go.uber.org/zap":
-		return name == "Logger" || name == "SugaredLogger"
-	case "log/slog":
-		return name == "Logger"
+	switch {
+	case pkgPath == "go.uber.org/zap" && name == "Logger":
+		return libZap
+	case pkgPath == "go.uber.org/zap" && name == "SugaredLogger":
+		return libZapSugar
+	case pkgPath == "log/slog" && name == "Logger":
+		return libSlog
+	case pkgPath == "log" && name == "Logger":
+		return libStdLog
+	case pkgPath == "github.com/sirupsen/logrus" && (name == "Logger" || name == "Entry"):
+		return libLogrus
+	case (pkgPath == "k8s.io/klog/v2" || pkgPath == "k8s.io/klog") && name == "Verbose":
+		return libKlog
 	default:
-		return false
+		return libUnknown
 	}
 }
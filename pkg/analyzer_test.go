@@ -1,19 +1,219 @@
 package pkg
 
 import (
+	"encoding/json"
 	"os"
 	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
 	"testing"
 
 	"golang.org/x/tools/go/analysis/analysistest"
 )
 
-func TestAll(t *testing.T) {
+// testdataDir returns the path to the testdata directory at the repository root.
+func testdataDir(t *testing.T) string {
+	t.Helper()
+
 	wd, err := os.Getwd()
 	if err != nil {
 		t.Fatalf("Failed to get wd: %s", err)
 	}
+	return filepath.Join(filepath.Dir(wd), "testdata")
+}
+
+// runWithConfig runs the analyzer on the testdata package pkgName, or on the given patterns
+// below it, and checks the suggested fixes against the .golden files. The config starts with
+// every rule off and applies the config.json placed in the package directory, so that it only
+// needs to enable the rule under test.
+func runWithConfig(t *testing.T, pkgName string, patterns ...string) {
+	t.Helper()
+
+	testdata := testdataDir(t)
+	dir := filepath.Join(testdata, "src", pkgName)
+	data, err := os.ReadFile(filepath.Join(dir, "config.json"))
+	if err != nil {
+		t.Fatalf("Failed to read config: %s", err)
+	}
+
+	cfg := rulesOffConfig()
+	if err := json.Unmarshal(data, cfg); err != nil {
+		t.Fatalf("Failed to parse config: %s", err)
+	}
+	if err := cfg.compile(dir); err != nil {
+		t.Fatalf("Failed to compile config: %s", err)
+	}
+	setTestConfig(t, cfg)
+	if cfg.EnableUniqueMessages {
+		requires := LogsAnalyzer.Requires
+		LogsAnalyzer.Requires = append(slices.Clone(requires), UniqueMessagesAnalyzer)
+		t.Cleanup(func() {
+			LogsAnalyzer.Requires = requires
+		})
+	}
+
+	if len(patterns) == 0 {
+		patterns = []string{pkgName}
+	}
+	analysistest.RunWithSuggestedFixes(t, testdata, LogsAnalyzer, patterns...)
+}
+
+// rulesOffConfig returns the default config with every enable_* option turned off.
+func rulesOffConfig() *Config {
+	cfg := DefaultConfig()
+	v := reflect.ValueOf(cfg).Elem()
+	for i := range v.NumField() {
+		field := v.Type().Field(i)
+		if field.Type.Kind() == reflect.Bool && strings.HasPrefix(field.Tag.Get("json"), "enable_") {
+			v.Field(i).SetBool(false)
+		}
+	}
+	return cfg
+}
+
+// setTestConfig makes the analyzer use cfg until the end of the test.
+func setTestConfig(t *testing.T, cfg *Config) {
+	t.Helper()
+
+	configMu.Lock()
+	currentConfig = cfg
+	configMu.Unlock()
+	t.Cleanup(func() {
+		configMu.Lock()
+		currentConfig = nil
+		configMu.Unlock()
+	})
+}
+
+func TestAll(t *testing.T) {
+	analysistest.Run(t, testdataDir(t), LogsAnalyzer, ".")
+}
+
+func TestFormatVerbs(t *testing.T) {
+	runWithConfig(t, "formatverbs")
+}
+
+func TestPrintfCheck(t *testing.T) {
+	runWithConfig(t, "printfcheck")
+}
+
+func TestErrorAttr(t *testing.T) {
+	runWithConfig(t, "errorattr")
+}
+
+func TestNoErrorString(t *testing.T) {
+	runWithConfig(t, "errorstring")
+}
+
+func TestLogAndReturn(t *testing.T) {
+	runWithConfig(t, "logandreturn")
+}
+
+func TestLogAndReturnDefaultAllowedFuncs(t *testing.T) {
+	runWithConfig(t, "logandreturndefault")
+}
+
+func TestFatalOutsideMain(t *testing.T) {
+	runWithConfig(t, "fatal", "fatal/...")
+}
+
+func TestContextLogging(t *testing.T) {
+	runWithConfig(t, "contextlogging")
+}
+
+func TestNoGlobalLogger(t *testing.T) {
+	runWithConfig(t, "globallogger", "globallogger/...")
+}
+
+func TestLoopLogging(t *testing.T) {
+	runWithConfig(t, "looplogging")
+}
+
+func TestExpensiveDebugArgs(t *testing.T) {
+	runWithConfig(t, "expensiveargs")
+}
+
+func TestUniqueMessages(t *testing.T) {
+	runWithConfig(t, "uniqueness", "uniqueness/...")
+}
+
+// TestUniqueMessagesFacts checks the messages exported as a fact by UniqueMessagesAnalyzer,
+// which leaves reporting its diagnostics to LogsAnalyzer.
+func TestUniqueMessagesFacts(t *testing.T) {
+	setTestConfig(t, DefaultConfig())
+	analysistest.Run(t, testdataDir(t), UniqueMessagesAnalyzer, "uniquenessfacts")
+}
+
+func TestConfigFlagValue(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{name: "equals", args: []string{"-config=rules.json", "./..."}, want: "rules.json"},
+		{name: "separate_value", args: []string{"--config", "rules.json", "./..."}, want: "rules.json"},
+		{name: "absent", args: []string{"-fix", "./..."}, want: ""},
+		{name: "other_flag", args: []string{"-configs=rules.json"}, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := configFlagValue(tt.args); got != tt.want {
+				t.Errorf("expected %q, got %q: %s", tt.want, got, tt.name)
+			}
+		})
+	}
+}
+
+func TestRequiredFields(t *testing.T) {
+	runWithConfig(t, "requiredfields", "requiredfields/...")
+}
+
+func TestBannedPhrases(t *testing.T) {
+	runWithConfig(t, "bannedphrases")
+}
+
+func TestSpellCheck(t *testing.T) {
+	runWithConfig(t, "spellcheck")
+}
+
+func TestMessagePatterns(t *testing.T) {
+	runWithConfig(t, "messagepatterns")
+}
+
+func TestPII(t *testing.T) {
+	runWithConfig(t, "pii")
+}
+
+func TestSecrets(t *testing.T) {
+	runWithConfig(t, "secrets")
+}
+
+func TestEntropy(t *testing.T) {
+	runWithConfig(t, "entropy")
+}
+
+func TestSensitiveKeywords(t *testing.T) {
+	runWithConfig(t, "sensitivekeywords")
+}
+
+func TestSensitiveConfig(t *testing.T) {
+	runWithConfig(t, "sensitiveconfig")
+}
+
+func TestConfidence(t *testing.T) {
+	runWithConfig(t, "confidence")
+}
+
+func TestKeywordValues(t *testing.T) {
+	runWithConfig(t, "keywordvalues")
+}
+
+func TestRedaction(t *testing.T) {
+	runWithConfig(t, "redaction")
+}
 
-	testdata := filepath.Join(filepath.Dir(wd), "testdata")
-	analysistest.Run(t, testdata, LogsAnalyzer, "")
+func TestSensitiveArgs(t *testing.T) {
+	runWithConfig(t, "sensitiveargs")
 }
@@ -0,0 +1,121 @@
+package pkg
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// bannedPhrase is a compiled entry of the banned phrases dictionary.
+type bannedPhrase struct {
+	phrase      string
+	pattern     *regexp.Regexp
+	replacement string
+}
+
+// compileBannedPhrases compiles the banned phrases dictionary into case-insensitive regexes
+// matching on word boundaries, ordered by phrase so diagnostics are deterministic.
+func compileBannedPhrases(phrases map[string]string) ([]bannedPhrase, error) {
+	compiled := make([]bannedPhrase, 0, len(phrases))
+	for phrase, replacement := range phrases {
+		pattern, err := regexp.Compile(`(?i)\b(?:` + phrase + `)\b`)
+		if err != nil {
+			return nil, fmt.Errorf("banned_phrases: %q: %w", phrase, err)
+		}
+		compiled = append(compiled, bannedPhrase{phrase: phrase, pattern: pattern, replacement: replacement})
+	}
+	sort.Slice(compiled, func(i, j int) bool {
+		return compiled[i].phrase < compiled[j].phrase
+	})
+	return compiled, nil
+}
+
+// checkBannedPhrases checks if the log message contains phrases from the banned phrases dictionary
+// and reports each occurrence, with a fix rewriting it when the dictionary has a replacement.
+func checkBannedPhrases(pass *analysis.Pass, lc *logCall, phrases []bannedPhrase) {
+	lit, msg, ok := messageLiteral(lc)
+	if !ok {
+		return
+	}
+
+	var reported [][]int
+
+	for _, bp := range phrases {
+		for _, loc := range bp.pattern.FindAllStringSubmatchIndex(msg, -1) {
+			if overlapsAny(loc, reported) {
+				continue
+			}
+			reported = append(reported, loc)
+
+			match := msg[loc[0]:loc[1]]
+			diag := analysis.Diagnostic{
+				Pos:     lit.Pos(),
+				End:     lit.End(),
+				Message: fmt.Sprintf("log message contains banned phrase %q", match),
+			}
+			pos, end, mappable := literalRange(lit, loc[0], loc[1])
+			if mappable {
+				diag.Pos, diag.End = pos, end
+			}
+			if bp.replacement != "" {
+				replacement := string(bp.pattern.ExpandString(nil, bp.replacement, msg, loc))
+				diag.Message += fmt.Sprintf("; use %q instead", replacement)
+				if text, ok := literalText(lit, replacement); ok && mappable {
+					diag.SuggestedFixes = []analysis.SuggestedFix{{
+						Message:   fmt.Sprintf("Replace %q with %q", match, replacement),
+						TextEdits: []analysis.TextEdit{{Pos: diag.Pos, End: diag.End, NewText: []byte(text)}},
+					}}
+				}
+			}
+			pass.Report(diag)
+		}
+	}
+}
+
+// messageLiteral returns the string literal holding the log message and its unquoted value.
+func messageLiteral(lc *logCall) (*ast.BasicLit, string, bool) {
+	lit, ok := lc.message().(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return nil, "", false
+	}
+	msg, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return nil, "", false
+	}
+	return lit, msg, true
+}
+
+// literalRange maps byte offsets in the unquoted message to positions in the string literal.
+// It fails for interpreted literals with escape sequences, whose offsets do not map directly.
+func literalRange(lit *ast.BasicLit, start, end int) (token.Pos, token.Pos, bool) {
+	if strings.Contains(lit.Value, `\`) {
+		return token.NoPos, token.NoPos, false
+	}
+	return lit.Pos() + 1 + token.Pos(start), lit.Pos() + 1 + token.Pos(end), true
+}
+
+// literalText returns the text to put inside the string literal to represent s,
+// escaped for interpreted literals, or false if a raw literal cannot hold it.
+func literalText(lit *ast.BasicLit, s string) (string, bool) {
+	if strings.HasPrefix(lit.Value, "`") {
+		return s, !strings.Contains(s, "`")
+	}
+	quoted := strconv.Quote(s)
+	return quoted[1 : len(quoted)-1], true
+}
+
+// overlapsAny reports whether the match location overlaps one of the already reported locations.
+func overlapsAny(loc []int, reported [][]int) bool {
+	for _, other := range reported {
+		if loc[0] < other[1] && other[0] < loc[1] {
+			return true
+		}
+	}
+	return false
+}
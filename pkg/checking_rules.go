@@ -1,37 +1,29 @@
 package pkg
 
 import (
+	"fmt"
 	"go/token"
 	"regexp"
 	"strings"
+	"sync"
 	"unicode"
 
 	"golang.org/x/tools/go/analysis"
 )
 
-// secretPatterns contains regex patterns for common secrets that should not be included in log messages.
-var secretPatterns = []struct {
-	pattern *regexp.Regexp
-	name    string
-}{
-	// JWT Tokens
-	{regexp.MustCompile(`eyJ[A-Za-z0-9_-]{10,}\.[A-Za-z0-9_-]{10,}\.[A-Za-z0-9_-]{10,}`), "JWT token"},
-
-	// GitHub
-	{regexp.MustCompile(`ghp_[0-9a-zA-Z]{36}`), "GitHub Personal Access Token"},
-	{regexp.MustCompile(`gho_[0-9a-zA-Z]{36}`), "GitHub OAuth Access Token"},
-	{regexp.MustCompile(`ghr_[0-9a-zA-Z]{36}`), "GitHub Refresh Token"},
-
-	// Private Keys
-	{regexp.MustCompile(`-----BEGIN (RSA |EC |DSA |OPENSSH |PGP )?PRIVATE KEY( BLOCK)?-----`), "Private Key"},
-
-	// Generic patterns
-	{regexp.MustCompile(`[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}`), "UUID (potential secret)"},
-
-	// Tokens
-	{regexp.MustCompile(`(?i)(bearer|token)['"]?\s*[:=]\s*['"]?[0-9a-zA-Z\-_.]{20,}`), "Bearer/Auth Token"},
+// sensitiveKeywords contains keywords that suggest a log message carries sensitive data.
+var sensitiveKeywords = []string{
+	"password", "passwd", "pwd",
+	"token", "api_key", "apikey",
+	"secret", "private_key", "privatekey",
+	"access_key", "accesskey",
+	"client_secret", "clientsecret",
+	"bearer",
 }
 
+// attachedLiteralValue matches a literal value following a sensitive keyword, such as ": hunter2".
+var attachedLiteralValue = regexp.MustCompile(`^['"]?\s*[:=]\s*['"]?([^\s'",;%][^\s'",;]*)`)
+
 // isLowercaseStartValid checks if the log message starts with a lowercase letter.
 func isLowercaseStartValid(msg string) bool {
 	msg = strings.TrimSpace(msg)
@@ -72,29 +64,19 @@ func isNoSpecialCharsValid(msg string) bool {
 	return false
 }
 
-// isNoSensitiveDataValid checks if the log message contains any sensitive data based on keywords and regex patterns.
-func isNoSensitiveDataValid(msg string) bool {
-	lowerMsg := strings.ToLower(msg)
-	sensitiveKeywords := []string{
-		"password", "passwd", "pwd",
-		"token", "api_key", "apikey",
-		"secret", "private_key", "privatekey",
-		"access_key", "accesskey",
-		"client_secret", "clientsecret",
-		"bearer",
-	}
+// defaultSensitiveMatcher matches the default sensitive keywords and secret detectors.
+var defaultSensitiveMatcher = sync.OnceValue(func() *sensitiveMatcher {
+	return newSensitiveMatcher(sensitiveKeywords, secretDetectors, nil)
+})
 
-	for _, keyword := range sensitiveKeywords {
-		if strings.Contains(lowerMsg, keyword) {
-			return true
-		}
-	}
-	for _, sp := range secretPatterns {
-		if sp.pattern.MatchString(msg) {
-			return true
-		}
+// isNoSensitiveDataValid checks if the log message contains any sensitive data based on
+// the default keywords and secret detectors.
+func isNoSensitiveDataValid(msg string) bool {
+	m := defaultSensitiveMatcher()
+	if _, ok := m.findKeyword(msg); ok {
+		return true
 	}
-	return false
+	return len(m.findValues(msg)) > 0
 }
 
 // checkLowercaseStart checks if the log message starts with a lowercase letter
@@ -169,11 +151,36 @@ func checkNoSpecialChars(pass *analysis.Pass, pos token.Pos, msg string) {
 	}
 }
 
-// checkNoSensitiveData checks if the log message contains any sensitive data based on keywords
-// and regex patterns, and reports an issue if it does.
-func checkNoSensitiveData(pass *analysis.Pass, pos token.Pos, msg string) {
-	if isNoSensitiveDataValid(msg) {
-		pass.Reportf(pos, "log message should not contain sensitive data")
+// checkNoSensitiveData checks if the log message contains a sensitive keyword, as a whole word
+// or identifier segment, and reports an issue naming it if it does. A bare mention has low
+// confidence and is only reported when mentions is set; a literal value attached to the keyword,
+// as in "password: hunter2", has medium confidence and comes with a fix replacing it with [REDACTED].
+// Secrets and PII are found by the detectors of checkSensitiveValues.
+func checkNoSensitiveData(pass *analysis.Pass, lc *logCall, m *sensitiveMatcher, mentions bool) {
+	lit, msg, ok := messageLiteral(lc)
+	if !ok {
+		return
+	}
+
+	for _, match := range m.findKeywords(msg) {
+		loc := attachedLiteralValue.FindStringSubmatchIndex(msg[match.end:])
+		if loc == nil && !mentions {
+			continue
+		}
+
+		diag := analysis.Diagnostic{
+			Pos:     lit.Pos(),
+			Message: fmt.Sprintf("log message contains sensitive keyword %q", match.keyword),
+		}
+		conf := confidenceLow
+		if loc != nil {
+			conf = confidenceMedium
+			if fix, ok := redactLiteralFix(lit, match.end+loc[2], match.end+loc[3]); ok {
+				diag.SuggestedFixes = []analysis.SuggestedFix{fix}
+			}
+		}
+		m.report(pass, diag, conf)
+		return
 	}
 }
 
@@ -283,3 +283,74 @@ func TestIsNoSensitiveDataValid(t *testing.T) {
 		})
 	}
 }
+
+// TestSensitiveKeywordBoundaries tests the sensitive keywords of sensitiveMatcher to ensure
+// they match whole words and identifier segments only, outside allowlisted phrases.
+func TestSensitiveKeywordBoundaries(t *testing.T) {
+	tests := []struct {
+		name        string
+		msg         string
+		expectError bool
+	}{
+		{
+			name:        "keyword_in_snake_case",
+			msg:         "refresh access_token failed",
+			expectError: true,
+		},
+		{
+			name:        "keyword_in_camel_case",
+			msg:         "userPassword rejected",
+			expectError: true,
+		},
+		{
+			name:        "keyword_in_kebab_case",
+			msg:         "x-api-key header missing",
+			expectError: true,
+		},
+		{
+			name:        "multi_segment_keyword_camel_case",
+			msg:         "APIKey rotated",
+			expectError: true,
+		},
+		{
+			name:        "keyword_prefix_of_word",
+			msg:         "tokenizer initialized",
+			expectError: false,
+		},
+		{
+			name:        "keyword_prefix_of_passwordless",
+			msg:         "passwordless login enabled",
+			expectError: false,
+		},
+		{
+			name:        "keyword_prefix_of_secretary",
+			msg:         "secretary assigned",
+			expectError: false,
+		},
+		{
+			name:        "allowlisted_phrase",
+			msg:         "Token bucket refilled",
+			expectError: false,
+		},
+		{
+			name:        "keyword_outside_allowlisted_phrase",
+			msg:         "token bucket refilled for token",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := newSensitiveMatcher(sensitiveKeywords, secretDetectors, []string{"token bucket"})
+			_, result := m.findKeyword(tt.msg)
+
+			if tt.expectError && !result {
+				t.Errorf("expected error, but got none: %s", tt.name)
+			}
+			if !tt.expectError && result {
+				t.Errorf("expected no error, but got one: %s", tt.name)
+			}
+		})
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
+	"slices"
 	"strings"
 	"sync"
 )
@@ -20,6 +22,216 @@ type Config struct {
 
 	// EnableSensitivePatterns checks if log messages do not contain sensitive information
 	EnableSensitivePatterns bool `json:"enable_sensitive_patterns"`
+
+	// SensitiveAllowlist lists phrases known to be safe, such as "token bucket"; sensitive keywords
+	// and values inside them are not reported.
+	SensitiveAllowlist []string `json:"sensitive_allowlist"`
+
+	// SensitiveKeywords adds keywords to and removes keywords from the built-in list of sensitive keywords.
+	SensitiveKeywords SensitiveKeywords `json:"sensitive_keywords"`
+
+	// SensitiveKeywordMode is "mention" to report every sensitive keyword in a constant log message,
+	// or "value" to report a keyword only when a value is attached to it, as in "password: " + pwd,
+	// "token=%s", "password: hunter2" or a field keyed "password".
+	SensitiveKeywordMode string `json:"sensitive_keyword_mode"`
+
+	// RedactionHelper is the function, as "import/path.Func", that fixes of sensitive values wrap them in,
+	// such as "github.com/acme/redact.String"; without it, the fixes remove sensitive fields instead.
+	RedactionHelper string `json:"redaction_helper"`
+
+	// SensitivePatterns lists named regexes of team-specific secrets, such as internal token formats;
+	// they are checked before the built-in detectors.
+	SensitivePatterns []SensitivePattern `json:"sensitive_patterns"`
+
+	// MinConfidence is the lowest confidence ("low", "medium", "high") of the sensitive data findings
+	// to report; detectors of a lower confidence, such as the UUID one, are turned off, and keyword
+	// findings of a lower confidence, such as bare mentions, are skipped.
+	MinConfidence string `json:"min_confidence"`
+
+	// EnablePIICards checks if log messages and constant field values do not contain card numbers,
+	// validated with the Luhn checksum; it requires EnableSensitivePatterns.
+	EnablePIICards bool `json:"enable_pii_cards"`
+
+	// EnablePIIIBANs checks if log messages and constant field values do not contain IBANs,
+	// validated with their mod 97 checksum; it requires EnableSensitivePatterns.
+	EnablePIIIBANs bool `json:"enable_pii_ibans"`
+
+	// EnablePIIPhones checks if log messages and constant field values do not contain E.164 phone numbers;
+	// it requires EnableSensitivePatterns.
+	EnablePIIPhones bool `json:"enable_pii_phones"`
+
+	// EnablePIIEmails checks if log messages and constant field values do not contain email addresses;
+	// it requires EnableSensitivePatterns.
+	EnablePIIEmails bool `json:"enable_pii_emails"`
+
+	// EnablePIIIPs checks if log messages and constant field values do not contain IPv4 or IPv6 addresses,
+	// other than loopback and unspecified ones; it requires EnableSensitivePatterns.
+	EnablePIIIPs bool `json:"enable_pii_ips"`
+
+	// EnableEntropyCheck checks if log messages and constant field values do not contain high-entropy
+	// tokens, such as secrets without a recognizable prefix; it requires EnableSensitivePatterns.
+	EnableEntropyCheck bool `json:"enable_entropy_check"`
+
+	// EntropyMinLength is the minimum length of tokens checked for entropy.
+	EntropyMinLength int `json:"entropy_min_length"`
+
+	// EntropyBase64Threshold is the Shannon entropy, in bits per character, from which tokens
+	// of the base64 alphabet are reported. It applies to tokens of 64 characters and more and is
+	// scaled down for shorter tokens, which cannot use the whole alphabet.
+	EntropyBase64Threshold float64 `json:"entropy_base64_threshold"`
+
+	// EntropyHexThreshold is the Shannon entropy, in bits per character, from which tokens
+	// of hex digits are reported. It applies to tokens of 16 characters and more and is
+	// scaled down for shorter tokens.
+	EntropyHexThreshold float64 `json:"entropy_hex_threshold"`
+
+	// EnableFormatVerbs checks if messages of non-printf logging methods do not contain format verbs.
+	EnableFormatVerbs bool `json:"enable_format_verbs"`
+
+	// EnablePrintfCheck checks if format strings of printf-style logging methods match their arguments.
+	EnablePrintfCheck bool `json:"enable_printf_check"`
+
+	// PrintfLoggers declares additional printf-style logging functions and methods,
+	// as "import/path.Func" or "import/path.Type.Method", whose format strings are checked too.
+	PrintfLoggers []string `json:"printf_loggers"`
+
+	// EnableErrorAttr checks if Error-level log calls inside `if err != nil` branches carry the checked error.
+	EnableErrorAttr bool `json:"enable_error_attr"`
+
+	// EnableNoErrorString checks if errors are passed to log calls as typed fields rather than as err.Error() strings.
+	EnableNoErrorString bool `json:"enable_no_error_string"`
+
+	// EnableLogAndReturn checks if errors are not both logged and returned from the same branch.
+	EnableLogAndReturn bool `json:"enable_log_and_return"`
+
+	// LogAndReturnAllowedFuncs lists glob patterns of functions, as "Func" or "Type.Method",
+	// where logging and returning the same error is allowed, such as top-level boundaries.
+	LogAndReturnAllowedFuncs []string `json:"log_and_return_allowed_funcs"`
+
+	// EnableFatalOutsideMain checks if Fatal and Panic-level log calls are made only in package main.
+	EnableFatalOutsideMain bool `json:"enable_fatal_outside_main"`
+
+	// FatalAllowedPackages lists package path globs, such as "cmd/...", where Fatal and Panic-level calls are allowed.
+	FatalAllowedPackages []string `json:"fatal_allowed_packages"`
+
+	// EnableContextLogging checks if log calls pass the context.Context available in scope to the logger.
+	EnableContextLogging bool `json:"enable_context_logging"`
+
+	// EnableNoGlobalLogger checks if global loggers (slog.Info, zap.L(), log.Printf, logrus.Info, ...)
+	// are not used outside the allowed packages.
+	EnableNoGlobalLogger bool `json:"enable_no_global_logger"`
+
+	// GlobalLoggerAllowedPackages lists package path globs, such as "cmd/...", where global loggers are allowed.
+	GlobalLoggerAllowedPackages []string `json:"global_logger_allowed_packages"`
+
+	// EnableLoopLogging checks if log calls inside loop bodies are guarded by a level check or sampling.
+	EnableLoopLogging bool `json:"enable_loop_logging"`
+
+	// LoopLogMinLevel is the lowest level ("debug", "info", "warn", "error") of log calls reported inside loops.
+	LoopLogMinLevel string `json:"loop_log_min_level"`
+
+	// LoopLogSamplingFuncs lists sampling functions, as "import/path.Func" or "import/path.Type.Method",
+	// which guard log calls inside loops when used in an if condition or given the logging closure.
+	LoopLogSamplingFuncs []string `json:"loop_log_sampling_funcs"`
+
+	// EnableExpensiveDebugArgs checks if Debug-level log calls do not evaluate expensive arguments unguarded.
+	EnableExpensiveDebugArgs bool `json:"enable_expensive_debug_args"`
+
+	// ExpensiveFuncs lists functions, as "import/path.Func" or "import/path.Type.Method", considered
+	// expensive in Debug-level arguments; the "*" entry makes every function call expensive.
+	ExpensiveFuncs []string `json:"expensive_funcs"`
+
+	// EnableUniqueMessages checks if constant log messages are unique across the package and its dependencies.
+	// Sibling packages that do not import each other are not compared.
+	EnableUniqueMessages bool `json:"enable_unique_messages"`
+
+	// MessageSimilarityThreshold reports messages whose normalized edit distance to another message
+	// is below it, e.g. 0.1; zero disables near-duplicate detection.
+	MessageSimilarityThreshold float64 `json:"message_similarity_threshold"`
+
+	// EnableRequiredFields checks if log calls carry the field keys required by RequiredFields.
+	EnableRequiredFields bool `json:"enable_required_fields"`
+
+	// RequiredFields lists the policies of field keys log calls must carry, per package and level.
+	RequiredFields []RequiredFieldsPolicy `json:"required_fields"`
+
+	// EnableBannedPhrases checks if log messages do not contain phrases from BannedPhrases.
+	EnableBannedPhrases bool `json:"enable_banned_phrases"`
+
+	// BannedPhrases maps a banned word, phrase or regex, matched case-insensitively on word boundaries,
+	// to its replacement; an empty replacement only reports the phrase. Replacements may refer
+	// to regex groups as $1.
+	BannedPhrases map[string]string `json:"banned_phrases"`
+
+	// EnableSpellCheck checks the words of log messages against an embedded English dictionary.
+	EnableSpellCheck bool `json:"enable_spell_check"`
+
+	// SpellCheckWords lists additional words accepted by the spell check, such as product names.
+	SpellCheckWords []string `json:"spell_check_words"`
+
+	// SpellCheckWordLists lists files with additional words, one per line, accepted by the spell check.
+	// Relative paths are resolved against the directory of the config file.
+	SpellCheckWordLists []string `json:"spell_check_word_lists"`
+
+	// EnableMessagePatterns checks if log messages match the MessagePatterns of their level.
+	EnableMessagePatterns bool `json:"enable_message_patterns"`
+
+	// MessagePatterns maps a level ("debug", "info", "warn", "error", "fatal", "panic")
+	// to the regexes the messages of log calls of that level must or must not match.
+	MessagePatterns map[string]MessagePatterns `json:"message_patterns"`
+
+	loopLogMinLevel logLevel
+	bannedPhrases   []bannedPhrase
+	spellChecker    *spellChecker
+	messagePatterns map[logLevel]compiledMessagePatterns
+	sensitive       *sensitiveMatcher
+	redaction       *redactionHelper
+}
+
+// RequiredFieldsPolicy requires log calls of at least MinLevel in the packages matching Packages
+// to carry the Fields keys, either as arguments or through With() on the logger.
+type RequiredFieldsPolicy struct {
+	// Packages is a package path glob, such as "internal/billing/..."; empty matches every package.
+	Packages string `json:"packages"`
+
+	// MinLevel is the lowest level ("debug", "info", "warn", "error") the policy applies to; empty means all levels.
+	MinLevel string `json:"min_level"`
+
+	// Fields lists the keys required in matching log calls, such as "request_id".
+	Fields []string `json:"fields"`
+
+	minLevel logLevel
+}
+
+// MessagePatterns declares the shape of log messages of one level.
+type MessagePatterns struct {
+	// MustMatch lists regexes of which messages must match at least one, such as "^failed to ".
+	MustMatch []string `json:"must_match"`
+
+	// MustNotMatch lists regexes messages must not match, such as `(?i)\berror\b`.
+	MustNotMatch []string `json:"must_not_match"`
+}
+
+// SensitiveKeywords adjusts the built-in list of sensitive keywords.
+type SensitiveKeywords struct {
+	// Add lists keywords to report in addition to the built-in ones, such as "session_id".
+	Add []string `json:"add"`
+
+	// Remove lists built-in keywords not to report, such as "pwd".
+	Remove []string `json:"remove"`
+}
+
+// SensitivePattern is a named regex of a secret; if the regex has a capturing group,
+// only the group is reported.
+type SensitivePattern struct {
+	// Name describes the matched value in diagnostics, such as "Acme API key".
+	Name string `json:"name"`
+
+	// Regex is the pattern of the secret, such as `acme_[0-9a-f]{32}`.
+	Regex string `json:"regex"`
+
+	// Confidence is how likely a match is an actual secret ("low", "medium", "high"); empty means "high".
+	Confidence string `json:"confidence"`
 }
 
 // currentConfig holds the global configuration for the analyzer, protected by configMu for concurrent access.
@@ -28,14 +240,38 @@ var (
 	currentConfig *Config
 )
 
+// loadedConfigs caches the configs loaded by ResolveConfig by path, so that a config file is read and
+// compiled, with its regexes and automata, once per run rather than once per analyzed package.
+var (
+	loadedConfigsMu sync.Mutex
+	loadedConfigs   = make(map[string]func() (*Config, error))
+)
+
 // DefaultConfig returns a Config with default settings, which can be used when no custom configuration is provided.
 func DefaultConfig() *Config {
-	return &Config{
-		EnableLowercaseStart:    true,
-		EnableEnglishOnly:       true,
-		EnableNoSpecialChars:    true,
-		EnableSensitivePatterns: true,
+	cfg := &Config{
+		EnableLowercaseStart:     true,
+		EnableEnglishOnly:        true,
+		EnableNoSpecialChars:     true,
+		EnableSensitivePatterns:  true,
+		SensitiveKeywordMode:     keywordModeMention,
+		MinConfidence:            "low",
+		EntropyMinLength:         20,
+		EntropyBase64Threshold:   4.5,
+		EntropyHexThreshold:      3,
+		LogAndReturnAllowedFuncs: []string{"main", "*.ServeHTTP"},
+		LoopLogMinLevel:          "debug",
+		ExpensiveFuncs: []string{
+			"encoding/json.Marshal",
+			"fmt.Sprintf",
+			"github.com/davecgh/go-spew/spew.Sdump",
+			"*",
+		},
+	}
+	if err := cfg.compile(""); err != nil {
+		panic(fmt.Sprintf("invalid default config: %s", err))
 	}
+	return cfg
 }
 
 // GetConfig returns the current configuration if it was set.
@@ -45,16 +281,23 @@ func GetConfig() *Config {
 	return currentConfig
 }
 
-// ResolveConfig returns a config from SetConfig or loads it from the given path.
+// ResolveConfig returns a config from SetConfig or loads it from the given path, once per path.
+// A config file that cannot be read or parsed is an error rather than a fallback to the defaults.
 func ResolveConfig(path string) (*Config, error) {
 	if cfg := GetConfig(); cfg != nil {
 		return cfg, nil
 	}
-	cfg, err := LoadConfig(path)
-	if err != nil {
-		return DefaultConfig(), nil
+
+	loadedConfigsMu.Lock()
+	load, ok := loadedConfigs[path]
+	if !ok {
+		load = sync.OnceValues(func() (*Config, error) {
+			return LoadConfig(path)
+		})
+		loadedConfigs[path] = load
 	}
-	return cfg, nil
+	loadedConfigsMu.Unlock()
+	return load()
 }
 
 // LoadConfig reads and parses config from a JSON file.
@@ -72,6 +315,86 @@ func LoadConfig(path string) (*Config, error) {
 	if err := json.Unmarshal(data, cfg); err != nil {
 		return nil, fmt.Errorf("parse config: %w", err)
 	}
+	if err := cfg.compile(filepath.Dir(path)); err != nil {
+		return nil, fmt.Errorf("parse config: %w", err)
+	}
 
 	return cfg, nil
 }
+
+// compile validates the config values that need parsing and stores their parsed form.
+// Relative paths in the config are resolved against configDir.
+func (c *Config) compile(configDir string) error {
+	level, ok := parseLevel(c.LoopLogMinLevel)
+	if !ok {
+		return fmt.Errorf("loop_log_min_level: unknown level %q", c.LoopLogMinLevel)
+	}
+	c.loopLogMinLevel = level
+
+	for i := range c.RequiredFields {
+		policy := &c.RequiredFields[i]
+		policy.minLevel = levelDebug
+		if policy.MinLevel == "" {
+			continue
+		}
+		level, ok := parseLevel(policy.MinLevel)
+		if !ok {
+			return fmt.Errorf("required_fields[%d].min_level: unknown level %q", i, policy.MinLevel)
+		}
+		policy.minLevel = level
+	}
+
+	phrases, err := compileBannedPhrases(c.BannedPhrases)
+	if err != nil {
+		return err
+	}
+	c.bannedPhrases = phrases
+
+	if c.EnableSpellCheck {
+		team, err := loadWordLists(c.SpellCheckWordLists, c.SpellCheckWords, configDir)
+		if err != nil {
+			return err
+		}
+		c.spellChecker = &spellChecker{english: englishDictionary(), team: team}
+	}
+
+	patterns, err := compileMessagePatterns(c.MessagePatterns)
+	if err != nil {
+		return err
+	}
+	c.messagePatterns = patterns
+
+	keywords, err := compileSensitiveKeywords(c.SensitiveKeywords)
+	if err != nil {
+		return err
+	}
+	if c.SensitiveKeywordMode != keywordModeMention && c.SensitiveKeywordMode != keywordModeValue {
+		return fmt.Errorf("sensitive_keyword_mode: unknown mode %q", c.SensitiveKeywordMode)
+	}
+	if c.redaction, err = parseRedactionHelper(c.RedactionHelper); err != nil {
+		return err
+	}
+	custom, err := compileSensitivePatterns(c.SensitivePatterns)
+	if err != nil {
+		return err
+	}
+	detectors := slices.Concat(custom, secretDetectors,
+		piiDetectors(c.EnablePIICards, c.EnablePIIIBANs, c.EnablePIIPhones, c.EnablePIIEmails, c.EnablePIIIPs))
+	if c.EnableEntropyCheck {
+		if c.EntropyMinLength <= 0 {
+			return fmt.Errorf("entropy_min_length: must be positive, got %d", c.EntropyMinLength)
+		}
+		detectors = append(detectors, entropyDetectors(c.EntropyMinLength, c.EntropyBase64Threshold, c.EntropyHexThreshold)...)
+	}
+	minConfidence, ok := parseConfidence(c.MinConfidence)
+	if !ok {
+		return fmt.Errorf("min_confidence: unknown confidence %q", c.MinConfidence)
+	}
+	detectors = slices.DeleteFunc(detectors, func(d sensitiveDetector) bool {
+		return d.confidence < minConfidence
+	})
+	c.sensitive = newSensitiveMatcher(keywords, detectors, c.SensitiveAllowlist)
+	c.sensitive.minConfidence = minConfidence
+
+	return nil
+}
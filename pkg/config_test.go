@@ -0,0 +1,240 @@
+package pkg
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestLoadConfig tests the LoadConfig function to ensure it applies defaults
+// and rejects values that cannot be parsed
+func TestLoadConfig(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		expectError bool
+	}{
+		{
+			name:        "empty_object",
+			content:     `{}`,
+			expectError: false,
+		},
+		{
+			name:        "loop_min_level",
+			content:     `{"enable_loop_logging": true, "loop_log_min_level": "Warn"}`,
+			expectError: false,
+		},
+		{
+			name:        "unknown_loop_min_level",
+			content:     `{"loop_log_min_level": "verbose"}`,
+			expectError: true,
+		},
+		{
+			name:        "required_fields",
+			content:     `{"required_fields": [{"packages": "internal/billing/...", "min_level": "error", "fields": ["tenant_id"]}]}`,
+			expectError: false,
+		},
+		{
+			name:        "unknown_required_fields_min_level",
+			content:     `{"required_fields": [{"min_level": "critical", "fields": ["request_id"]}]}`,
+			expectError: true,
+		},
+		{
+			name:        "banned_phrases",
+			content:     `{"banned_phrases": {"couldn't": "failed to", "pls": "", "(successfully) successfully": "$1"}}`,
+			expectError: false,
+		},
+		{
+			name:        "invalid_banned_phrase",
+			content:     `{"banned_phrases": {"fail(ed": ""}}`,
+			expectError: true,
+		},
+		{
+			name:        "spell_check_words",
+			content:     `{"enable_spell_check": true, "spell_check_words": ["kubelet"]}`,
+			expectError: false,
+		},
+		{
+			name:        "missing_spell_check_word_list",
+			content:     `{"enable_spell_check": true, "spell_check_word_lists": ["missing.txt"]}`,
+			expectError: true,
+		},
+		{
+			name:        "message_patterns",
+			content:     `{"message_patterns": {"error": {"must_match": ["^failed to ", "^cannot "]}, "Info": {"must_not_match": ["error"]}}}`,
+			expectError: false,
+		},
+		{
+			name:        "unknown_message_patterns_level",
+			content:     `{"message_patterns": {"critical": {"must_match": ["^failed to "]}}}`,
+			expectError: true,
+		},
+		{
+			name:        "invalid_message_pattern",
+			content:     `{"message_patterns": {"error": {"must_not_match": ["[a-"]}}}`,
+			expectError: true,
+		},
+		{
+			name:        "entropy_check",
+			content:     `{"enable_entropy_check": true, "entropy_min_length": 24, "entropy_base64_threshold": 4.2}`,
+			expectError: false,
+		},
+		{
+			name:        "non_positive_entropy_min_length",
+			content:     `{"enable_entropy_check": true, "entropy_min_length": 0}`,
+			expectError: true,
+		},
+		{
+			name:        "sensitive_keywords",
+			content:     `{"sensitive_keywords": {"add": ["session_id", "Token"], "remove": ["PWD"]}}`,
+			expectError: false,
+		},
+		{
+			name:        "unknown_removed_sensitive_keyword",
+			content:     `{"sensitive_keywords": {"remove": ["passphrase"]}}`,
+			expectError: true,
+		},
+		{
+			name:        "empty_added_sensitive_keyword",
+			content:     `{"sensitive_keywords": {"add": ["--"]}}`,
+			expectError: true,
+		},
+		{
+			name:        "sensitive_patterns",
+			content:     `{"sensitive_patterns": [{"name": "Acme API key", "regex": "acme_[0-9a-f]{32}"}]}`,
+			expectError: false,
+		},
+		{
+			name:        "invalid_sensitive_pattern",
+			content:     `{"sensitive_patterns": [{"name": "Acme API key", "regex": "acme_[0-9a-f{32}"}]}`,
+			expectError: true,
+		},
+		{
+			name:        "unnamed_sensitive_pattern",
+			content:     `{"sensitive_patterns": [{"regex": "acme_[0-9a-f]{32}"}]}`,
+			expectError: true,
+		},
+		{
+			name:        "empty_matching_sensitive_pattern",
+			content:     `{"sensitive_patterns": [{"name": "Acme API key", "regex": "(acme_)?"}]}`,
+			expectError: true,
+		},
+		{
+			name:        "min_confidence",
+			content:     `{"min_confidence": "High", "sensitive_patterns": [{"name": "Acme session ID", "regex": "sess_[0-9a-f]{16}", "confidence": "low"}]}`,
+			expectError: false,
+		},
+		{
+			name:        "unknown_min_confidence",
+			content:     `{"min_confidence": "certain"}`,
+			expectError: true,
+		},
+		{
+			name:        "unknown_sensitive_pattern_confidence",
+			content:     `{"sensitive_patterns": [{"name": "Acme API key", "regex": "acme_[0-9a-f]{32}", "confidence": "certain"}]}`,
+			expectError: true,
+		},
+		{
+			name:        "sensitive_keyword_mode",
+			content:     `{"sensitive_keyword_mode": "value"}`,
+			expectError: false,
+		},
+		{
+			name:        "unknown_sensitive_keyword_mode",
+			content:     `{"sensitive_keyword_mode": "strict"}`,
+			expectError: true,
+		},
+		{
+			name:        "redaction_helper",
+			content:     `{"redaction_helper": "github.com/acme/redact.String"}`,
+			expectError: false,
+		},
+		{
+			name:        "invalid_redaction_helper",
+			content:     `{"redaction_helper": "redact"}`,
+			expectError: true,
+		},
+		{
+			name:        "invalid_json",
+			content:     `{"enable_lowercase_start": }`,
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			path := filepath.Join(t.TempDir(), "config.json")
+			if err := os.WriteFile(path, []byte(tt.content), 0o600); err != nil {
+				t.Fatalf("Failed to write config: %s", err)
+			}
+
+			_, err := LoadConfig(path)
+
+			if tt.expectError && err == nil {
+				t.Errorf("expected error, but got none: %s", tt.name)
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("expected no error, but got one: %s: %s", tt.name, err)
+			}
+		})
+	}
+}
+
+// TestResolveConfig tests the ResolveConfig function to ensure a config file
+// that cannot be loaded fails the run instead of falling back to the defaults
+func TestResolveConfig(t *testing.T) {
+	invalid := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(invalid, []byte(`{"loop_log_min_level": "verbose"}`), 0o600); err != nil {
+		t.Fatalf("Failed to write config: %s", err)
+	}
+
+	tests := []struct {
+		name string
+		path string
+	}{
+		{
+			name: "missing_file",
+			path: filepath.Join(t.TempDir(), "missing.json"),
+		},
+		{
+			name: "invalid_value",
+			path: invalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := ResolveConfig(tt.path)
+
+			if err == nil {
+				t.Errorf("expected error, but got config %+v: %s", cfg, tt.name)
+			}
+		})
+	}
+}
+
+// TestResolveConfigCache tests the ResolveConfig function to ensure a config file
+// is loaded and compiled once, and later calls share the result
+func TestResolveConfigCache(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(path, []byte(`{"enable_spell_check": true}`), 0o600); err != nil {
+		t.Fatalf("Failed to write config: %s", err)
+	}
+
+	first, err := ResolveConfig(path)
+	if err != nil {
+		t.Fatalf("Failed to resolve config: %s", err)
+	}
+	if err := os.Remove(path); err != nil {
+		t.Fatalf("Failed to remove config: %s", err)
+	}
+	second, err := ResolveConfig(path)
+	if err != nil {
+		t.Fatalf("expected the cached config, but got error: %s", err)
+	}
+
+	if first != second {
+		t.Errorf("expected the same config, got %p and %p", first, second)
+	}
+}
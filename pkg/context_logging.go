@@ -0,0 +1,92 @@
+package pkg
+
+import (
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// checkContextLogging checks if a log call ignores a context.Context available in scope,
+// which drops the values carried by the context such as trace IDs, and reports an issue
+// with a fix passing the context: InfoContext(ctx, ...) for slog and WithContext(ctx) for logrus.
+func checkContextLogging(pass *analysis.Pass, lc *logCall) {
+	var newName string
+	switch lc.lib {
+	case libSlog:
+		if lc.context {
+			return
+		}
+		newName = lc.base + "Context"
+	case libLogrus:
+		if hasContextInChain(lc.sel.X) {
+			return
+		}
+	default:
+		return
+	}
+
+	ctx := contextInScope(pass, lc.call)
+	if ctx == nil {
+		return
+	}
+
+	var edits []analysis.TextEdit
+	var message string
+	if newName != "" {
+		message = "use " + newName + " with " + ctx.Name() + ", since a context.Context is in scope"
+		edits = []analysis.TextEdit{
+			{Pos: lc.sel.Sel.Pos(), End: lc.sel.Sel.End(), NewText: []byte(newName)},
+			{Pos: lc.call.Lparen + 1, End: lc.call.Lparen + 1, NewText: []byte(ctx.Name() + ", ")},
+		}
+	} else {
+		message = "use WithContext(" + ctx.Name() + "), since a context.Context is in scope"
+		edits = []analysis.TextEdit{
+			{Pos: lc.sel.Sel.Pos(), End: lc.sel.Sel.Pos(), NewText: []byte("WithContext(" + ctx.Name() + ").")},
+		}
+	}
+
+	pass.Report(analysis.Diagnostic{
+		Pos:     lc.call.Pos(),
+		End:     lc.call.End(),
+		Message: message,
+		SuggestedFixes: []analysis.SuggestedFix{{
+			Message:   "Pass " + ctx.Name() + " to the logger",
+			TextEdits: edits,
+		}},
+	})
+}
+
+// contextInScope returns the innermost context.Context variable declared before the call
+// in the enclosing function scopes, or nil if there is none.
+func contextInScope(pass *analysis.Pass, call *ast.CallExpr) types.Object {
+	pkgScope := pass.Pkg.Scope()
+	for scope := pkgScope.Innermost(call.Pos()); scope != nil && scope != pkgScope; scope = scope.Parent() {
+		var found types.Object
+		for _, name := range scope.Names() {
+			obj, ok := scope.Lookup(name).(*types.Var)
+			if !ok || obj.Pos() >= call.Pos() || !isNamedType(obj.Type(), "context", "Context") {
+				continue
+			}
+			if found == nil || obj.Pos() > found.Pos() {
+				found = obj
+			}
+		}
+		if found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// hasContextInChain reports whether the logger expression already passes a context via WithContext.
+func hasContextInChain(expr ast.Expr) bool {
+	found := false
+	ast.Inspect(expr, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok && sel.Sel.Name == "WithContext" {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
@@ -0,0 +1,99 @@
+package pkg
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+)
+
+// uuidString matches a whole UUID, which has a high entropy but is usually an identifier.
+var uuidString = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// Alphabet sizes of the entropy detectors.
+const (
+	hexAlphabetSize    = 16
+	base64AlphabetSize = 64
+)
+
+// entropyDetectors returns detectors of tokens of at least minLength characters from the base64
+// alphabet whose Shannon entropy reaches the threshold of their alphabet: hexThreshold for tokens
+// made of hex digits and base64Threshold for the others. Both kinds must mix letters and digits.
+// Tokens directly following '%', such as format verbs, and UUIDs are skipped.
+func entropyDetectors(minLength int, base64Threshold, hexThreshold float64) []sensitiveDetector {
+	token := regexp.MustCompile(fmt.Sprintf(`(?:^|[^A-Za-z0-9+/=_%%-])([A-Za-z0-9+/=_-]{%d,})`, minLength))
+	return []sensitiveDetector{
+		{
+			name:       "high-entropy hex string",
+			confidence: confidenceLow,
+			pattern:    token,
+			validate: func(s string) bool {
+				return isHexString(s) && hasLetterAndDigit(s) &&
+					shannonEntropy(s) >= entropyThreshold(hexThreshold, len(s), hexAlphabetSize)
+			},
+		},
+		{
+			name:       "high-entropy base64 string",
+			confidence: confidenceMedium,
+			pattern:    token,
+			validate: func(s string) bool {
+				return !isHexString(s) && hasLetterAndDigit(s) && !uuidString.MatchString(s) &&
+					shannonEntropy(s) >= entropyThreshold(base64Threshold, len(s), base64AlphabetSize)
+			},
+		},
+	}
+}
+
+// entropyThreshold scales the threshold, given for tokens long enough to use the whole alphabet,
+// to a token of length n: such a token has at most log2(min(n, alphabetSize)) bits per character,
+// so a threshold of 4.5 for base64 becomes 3.24 for a 20-character token.
+func entropyThreshold(threshold float64, n, alphabetSize int) float64 {
+	if n >= alphabetSize {
+		return threshold
+	}
+	return threshold * math.Log2(float64(n)) / math.Log2(float64(alphabetSize))
+}
+
+// isHexString reports whether s consists of hex digits only.
+func isHexString(s string) bool {
+	for _, r := range s {
+		if !('0' <= r && r <= '9' || 'a' <= r && r <= 'f' || 'A' <= r && r <= 'F') {
+			return false
+		}
+	}
+	return true
+}
+
+// hasLetterAndDigit reports whether s contains both an ASCII letter and a digit,
+// which random tokens almost always do, unlike identifiers and decimal numbers.
+func hasLetterAndDigit(s string) bool {
+	letter, digit := false, false
+	for _, r := range s {
+		switch {
+		case '0' <= r && r <= '9':
+			digit = true
+		case 'a' <= r && r <= 'z' || 'A' <= r && r <= 'Z':
+			letter = true
+		}
+	}
+	return letter && digit
+}
+
+// shannonEntropy returns the Shannon entropy of s in bits per character.
+func shannonEntropy(s string) float64 {
+	if s == "" {
+		return 0
+	}
+
+	counts := make(map[rune]int)
+	for _, r := range s {
+		counts[r]++
+	}
+
+	entropy := 0.0
+	n := float64(len(s))
+	for _, count := range counts {
+		p := float64(count) / n
+		entropy -= p * math.Log2(p)
+	}
+	return entropy
+}
@@ -0,0 +1,96 @@
+package pkg
+
+import (
+	"math"
+	"testing"
+)
+
+// TestShannonEntropy tests the shannonEntropy function
+// to ensure it returns the entropy in bits per character
+func TestShannonEntropy(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want float64
+	}{
+		{name: "empty", s: "", want: 0},
+		{name: "single_symbol", s: "aaaa", want: 0},
+		{name: "two_symbols", s: "abab", want: 1},
+		{name: "sixteen_symbols", s: "0123456789abcdef", want: 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := shannonEntropy(tt.s)
+
+			if math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("expected %v, got %v: %s", tt.want, got, tt.name)
+			}
+		})
+	}
+}
+
+// TestEntropyThreshold tests the entropyThreshold function
+// to ensure thresholds are reachable by tokens shorter than their alphabet
+func TestEntropyThreshold(t *testing.T) {
+	tests := []struct {
+		name      string
+		threshold float64
+		n         int
+		alphabet  int
+		want      float64
+	}{
+		{name: "base64_full_alphabet", threshold: 4.5, n: 64, alphabet: 64, want: 4.5},
+		{name: "base64_longer", threshold: 4.5, n: 100, alphabet: 64, want: 4.5},
+		{name: "base64_min_length", threshold: 4.5, n: 20, alphabet: 64, want: 4.5 * math.Log2(20) / 6},
+		{name: "hex_full_alphabet", threshold: 3, n: 20, alphabet: 16, want: 3},
+		{name: "hex_short", threshold: 3, n: 8, alphabet: 16, want: 2.25},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := entropyThreshold(tt.threshold, tt.n, tt.alphabet)
+
+			if math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("expected %v, got %v: %s", tt.want, got, tt.name)
+			}
+			if maxEntropy := math.Log2(float64(min(tt.n, tt.alphabet))); got > maxEntropy {
+				t.Errorf("threshold %v is above the maximum entropy %v: %s", got, maxEntropy, tt.name)
+			}
+		})
+	}
+}
+
+// TestEntropyDetectorsMinLength tests the entropyDetectors function
+// to ensure random tokens of the minimum length are found and decimal IDs are not
+func TestEntropyDetectorsMinLength(t *testing.T) {
+	detectors := entropyDetectors(20, 4.5, 3)
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{name: "base64_min_length", s: "key q8Zr3LmN5vXw2KpT7yBj", want: "high-entropy base64 string"},
+		{name: "below_min_length", s: "key q8Zr3LmN5vXw2KpT7yB", want: ""},
+		{name: "decimal_id", s: "order 98172634509182736450", want: ""},
+		{name: "hex_digest", s: "digest 9f86d081884c7d659a2feaa0c55ad015", want: "high-entropy hex string"},
+		{name: "identifier", s: "running TestSomethingVeryLongName", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			findings := findSensitive(detectors, tt.s, nil)
+			got := ""
+			if len(findings) > 0 {
+				got = findings[0].detector.name
+			}
+
+			if got != tt.want {
+				t.Errorf("expected %q, got %q: %s", tt.want, got, tt.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package pkg
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// checkErrorAttr checks if an Error-level log call inside an `if err != nil` branch
+// carries the checked error, and reports an issue if it does not.
+func checkErrorAttr(pass *analysis.Pass, lc *logCall, stack []ast.Node) {
+	if lc.level != levelError {
+		return
+	}
+
+	errIdent := enclosingCheckedError(pass, stack)
+	if errIdent == nil {
+		return
+	}
+	errObj := pass.TypesInfo.ObjectOf(errIdent)
+	if usesObject(pass, lc.call, errObj) {
+		return
+	}
+
+	diag := analysis.Diagnostic{
+		Pos:     lc.call.Pos(),
+		End:     lc.call.End(),
+		Message: "Error-level log call should include the error " + errIdent.Name,
+	}
+	if fix, ok := errorAttrFix(pass, lc, errIdent.Name); ok {
+		diag.SuggestedFixes = []analysis.SuggestedFix{fix}
+	}
+	pass.Report(diag)
+}
+
+// enclosingCheckedError returns the error variable checked by the innermost enclosing
+// `if err != nil` statement whose body contains the current node, or nil if there is none.
+// The search stops at function boundaries.
+func enclosingCheckedError(pass *analysis.Pass, stack []ast.Node) *ast.Ident {
+	for i := len(stack) - 2; i >= 0; i-- {
+		switch node := stack[i].(type) {
+		case *ast.FuncDecl, *ast.FuncLit:
+			return nil
+		case *ast.IfStmt:
+			if stack[i+1] != node.Body {
+				continue
+			}
+			if ident := checkedError(pass, node.Cond); ident != nil {
+				return ident
+			}
+		}
+	}
+	return nil
+}
+
+// checkedError returns the error variable of an `err != nil` condition, or nil if cond is not one.
+func checkedError(pass *analysis.Pass, cond ast.Expr) *ast.Ident {
+	bin, ok := cond.(*ast.BinaryExpr)
+	if !ok || bin.Op != token.NEQ {
+		return nil
+	}
+
+	x, y := bin.X, bin.Y
+	if isNilIdent(pass, x) {
+		x, y = y, x
+	}
+	if !isNilIdent(pass, y) {
+		return nil
+	}
+
+	ident, ok := ast.Unparen(x).(*ast.Ident)
+	if !ok {
+		return nil
+	}
+	obj := pass.TypesInfo.ObjectOf(ident)
+	if obj == nil || !isErrorType(obj.Type()) {
+		return nil
+	}
+	return ident
+}
+
+// isNilIdent reports whether expr is the predeclared nil.
+func isNilIdent(pass *analysis.Pass, expr ast.Expr) bool {
+	ident, ok := ast.Unparen(expr).(*ast.Ident)
+	if !ok {
+		return false
+	}
+	_, isNil := pass.TypesInfo.ObjectOf(ident).(*types.Nil)
+	return isNil
+}
+
+// usesObject reports whether the node refers to the given object anywhere inside it.
+func usesObject(pass *analysis.Pass, node ast.Node, obj types.Object) bool {
+	if obj == nil {
+		return false
+	}
+
+	found := false
+	ast.Inspect(node, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+		if ident, ok := n.(*ast.Ident); ok && pass.TypesInfo.Uses[ident] == obj {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// errorAttrFix builds a fix attaching the error to the log call with the field constructor of its library.
+func errorAttrFix(pass *analysis.Pass, lc *logCall, errName string) (analysis.SuggestedFix, bool) {
+	edit, ok := errorFieldEdit(pass, lc, errName)
+	if !ok {
+		return analysis.SuggestedFix{}, false
+	}
+
+	return analysis.SuggestedFix{
+		Message:   "Add " + errName + " to log call",
+		TextEdits: []analysis.TextEdit{edit},
+	}, true
+}
+
+// errorFieldEdit returns an edit attaching the error to the log call: zap.Error for zap,
+// slog.Any for slog, an "error" pair for sugared kv methods and WithError for logrus.
+func errorFieldEdit(pass *analysis.Pass, lc *logCall, errText string) (analysis.TextEdit, bool) {
+	if lc.call.Ellipsis.IsValid() || len(lc.call.Args) == 0 {
+		return analysis.TextEdit{}, false
+	}
+
+	qualifier := importName(pass, fileOf(pass, lc.call.Pos()), libraryImportPath(lc.lib))
+
+	switch {
+	case lc.lib == libZap && qualifier != "":
+		return appendArgEdit(lc, ", "+qualifier+".Error("+errText+")"), true
+	case lc.lib == libSlog && qualifier != "":
+		return appendArgEdit(lc, ", "+qualifier+`.Any("error", `+errText+")"), true
+	case lc.lib == libZapSugar && lc.kind == kindKV:
+		return appendArgEdit(lc, `, "error", `+errText), true
+	case lc.lib == libLogrus:
+		return analysis.TextEdit{Pos: lc.sel.Sel.Pos(), End: lc.sel.Sel.Pos(), NewText: []byte("WithError(" + errText + ").")}, true
+	default:
+		return analysis.TextEdit{}, false
+	}
+}
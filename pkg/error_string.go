@@ -0,0 +1,175 @@
+package pkg
+
+import (
+	"go/ast"
+	"go/token"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// checkNoErrorString checks if an error is passed to a log call as a string via err.Error(),
+// losing its type, and reports an issue with a fix passing the error itself.
+func checkNoErrorString(pass *analysis.Pass, lc *logCall) {
+	for i := lc.msgIndex; i < len(lc.call.Args); i++ {
+		arg := lc.call.Args[i]
+		reported := false
+
+		switch {
+		case i == lc.msgIndex:
+			reported = checkErrorStringMessage(pass, lc, arg)
+		case isStringFieldCall(pass, lc, arg):
+			reported = checkErrorStringField(pass, lc, arg.(*ast.CallExpr))
+		default:
+			if errExpr, ok := errorStringCall(pass, arg); ok {
+				reportErrorString(pass, arg, errExpr, analysis.TextEdit{
+					Pos:     arg.Pos(),
+					End:     arg.End(),
+					NewText: []byte(exprText(pass, errExpr)),
+				})
+				reported = true
+			}
+		}
+
+		if !reported {
+			reportNestedErrorStrings(pass, arg)
+		}
+	}
+}
+
+// checkErrorStringMessage handles a message built as `"failed: " + err.Error()`,
+// moving the error out of the message into a typed field.
+func checkErrorStringMessage(pass *analysis.Pass, lc *logCall, msg ast.Expr) bool {
+	bin, ok := msg.(*ast.BinaryExpr)
+	if !ok || bin.Op != token.ADD {
+		return false
+	}
+	lit, ok := bin.X.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return false
+	}
+	errExpr, ok := errorStringCall(pass, bin.Y)
+	if !ok {
+		return false
+	}
+	prefix, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return false
+	}
+
+	newMsg := strconv.Quote(strings.TrimRight(prefix, " :=-"))
+	errText := exprText(pass, errExpr)
+	fieldEdit, ok := errorFieldEdit(pass, lc, errText)
+	if !ok {
+		reportErrorString(pass, bin.Y, errExpr)
+		return true
+	}
+
+	msgEdit := analysis.TextEdit{Pos: msg.Pos(), End: msg.End(), NewText: []byte(newMsg)}
+	if fieldEdit.Pos < msgEdit.Pos {
+		reportErrorString(pass, bin.Y, errExpr, fieldEdit, msgEdit)
+	} else {
+		reportErrorString(pass, bin.Y, errExpr, msgEdit, fieldEdit)
+	}
+	return true
+}
+
+// checkErrorStringField handles `zap.String("error", err.Error())` and `slog.String("err", err.Error())`,
+// replacing them with zap.Error / zap.NamedError and slog.Any.
+func checkErrorStringField(pass *analysis.Pass, lc *logCall, field *ast.CallExpr) bool {
+	errExpr, ok := errorStringCall(pass, field.Args[1])
+	if !ok {
+		return false
+	}
+
+	qualifier := field.Fun.(*ast.SelectorExpr).X
+	errText := exprText(pass, errExpr)
+	keyText := exprText(pass, field.Args[0])
+
+	var newText string
+	switch {
+	case lc.lib == libSlog:
+		newText = exprText(pass, qualifier) + ".Any(" + keyText + ", " + errText + ")"
+	case keyText == `"error"`:
+		newText = exprText(pass, qualifier) + ".Error(" + errText + ")"
+	default:
+		newText = exprText(pass, qualifier) + ".NamedError(" + keyText + ", " + errText + ")"
+	}
+
+	reportErrorString(pass, field.Args[1], errExpr, analysis.TextEdit{
+		Pos:     field.Pos(),
+		End:     field.End(),
+		NewText: []byte(newText),
+	})
+	return true
+}
+
+// reportNestedErrorStrings reports err.Error() calls nested deeper inside an argument, without a fix.
+func reportNestedErrorStrings(pass *analysis.Pass, arg ast.Expr) {
+	ast.Inspect(arg, func(n ast.Node) bool {
+		expr, ok := n.(ast.Expr)
+		if !ok {
+			return true
+		}
+		if errExpr, ok := errorStringCall(pass, expr); ok {
+			reportErrorString(pass, expr, errExpr)
+			return false
+		}
+		return true
+	})
+}
+
+// reportErrorString reports an err.Error() call, attaching the edits as a fix if there are any.
+func reportErrorString(pass *analysis.Pass, call ast.Expr, errExpr ast.Expr, edits ...analysis.TextEdit) {
+	errText := exprText(pass, errExpr)
+	diag := analysis.Diagnostic{
+		Pos:     call.Pos(),
+		End:     call.End(),
+		Message: "log call should pass the error " + errText + " as a typed field instead of " + errText + ".Error()",
+	}
+	if len(edits) > 0 {
+		diag.SuggestedFixes = []analysis.SuggestedFix{{
+			Message:   "Pass " + errText + " as a typed error field",
+			TextEdits: edits,
+		}}
+	}
+	pass.Report(diag)
+}
+
+// errorStringCall checks if expr is a call of the Error method on an error value
+// and returns the error expression.
+func errorStringCall(pass *analysis.Pass, expr ast.Expr) (ast.Expr, bool) {
+	call, ok := ast.Unparen(expr).(*ast.CallExpr)
+	if !ok || len(call.Args) != 0 {
+		return nil, false
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "Error" {
+		return nil, false
+	}
+	if !isErrorType(pass.TypesInfo.TypeOf(sel.X)) {
+		return nil, false
+	}
+	return sel.X, true
+}
+
+// isStringFieldCall reports whether expr is a zap.String or slog.String call of the log call's library.
+func isStringFieldCall(pass *analysis.Pass, lc *logCall, expr ast.Expr) bool {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok || len(call.Args) != 2 {
+		return false
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "String" {
+		return false
+	}
+	path := selectorPackagePath(pass, sel)
+	return path != "" && path == libraryImportPath(lc.lib)
+}
+
+// appendArgEdit returns an edit appending text after the last argument of the log call.
+func appendArgEdit(lc *logCall, text string) analysis.TextEdit {
+	lastArg := lc.call.Args[len(lc.call.Args)-1]
+	return analysis.TextEdit{Pos: lastArg.End(), End: lastArg.End(), NewText: []byte(text)}
+}
@@ -0,0 +1,97 @@
+package pkg
+
+import (
+	"go/ast"
+	"go/types"
+	"slices"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// anyCall is the entry of the expensive functions list matching every function call.
+const anyCall = "*"
+
+// checkExpensiveDebugArgs checks if the arguments of a Debug-level log call evaluate expensive calls,
+// which run even when debug logging is disabled, and reports each of them unless the log call
+// is guarded by a level check.
+func checkExpensiveDebugArgs(pass *analysis.Pass, lc *logCall, stack []ast.Node, expensiveFuncs []string) {
+	if lc.level != levelDebug || isLevelGuarded(pass, stack) {
+		return
+	}
+
+	for _, arg := range lc.call.Args[min(lc.msgIndex, len(lc.call.Args)):] {
+		ast.Inspect(arg, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok || !isExpensiveCall(pass, call, expensiveFuncs) {
+				return true
+			}
+			pass.Reportf(call.Pos(), "Debug-level log call evaluates %s even when debug logging is disabled; %s",
+				exprText(pass, call), lazyFormattingHint(lc.lib))
+			return false
+		})
+	}
+}
+
+// isLevelGuarded reports whether the current node is inside an if statement checking the log level,
+// within the same function.
+func isLevelGuarded(pass *analysis.Pass, stack []ast.Node) bool {
+	for i := len(stack) - 2; i >= 0; i-- {
+		switch node := stack[i].(type) {
+		case *ast.FuncDecl, *ast.FuncLit:
+			return false
+		case *ast.IfStmt:
+			if stack[i+1] == node.Body && (isLoggingGuard(pass, node.Init, nil) || isLoggingGuard(pass, node.Cond, nil)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isExpensiveCall reports whether the call is in the configured expensive set. With the anyCall entry,
+// every function call counts except type conversions, builtins and attribute constructors of the logging libraries.
+func isExpensiveCall(pass *analysis.Pass, call *ast.CallExpr, expensiveFuncs []string) bool {
+	if tv, ok := pass.TypesInfo.Types[call.Fun]; ok && tv.IsType() {
+		return false
+	}
+
+	fn, name := calleeFunc(pass, call)
+	if name != "" && slices.Contains(expensiveFuncs, name) {
+		return true
+	}
+	if !slices.Contains(expensiveFuncs, anyCall) {
+		return false
+	}
+
+	if ident, ok := ast.Unparen(call.Fun).(*ast.Ident); ok {
+		if _, builtin := pass.TypesInfo.Uses[ident].(*types.Builtin); builtin {
+			return false
+		}
+	}
+	if fn != nil && isFieldPackage(fn.Pkg().Path()) {
+		return false
+	}
+	return true
+}
+
+// isFieldPackage reports whether the package provides typed attribute constructors of a supported library.
+func isFieldPackage(path string) bool {
+	switch path {
+	case "log/slog", "go.uber.org/zap", "go.uber.org/zap/zapcore":
+		return true
+	default:
+		return false
+	}
+}
+
+// lazyFormattingHint suggests how to defer formatting for the library of the log call.
+func lazyFormattingHint(lib logLibrary) string {
+	switch lib {
+	case libSlog:
+		return "implement slog.LogValuer for lazy formatting or guard the call with logger.Enabled"
+	case libZap, libZapSugar:
+		return "use zap.Stringer or zap.Object for lazy formatting or guard the call with logger.Check"
+	default:
+		return "guard the call with a level check"
+	}
+}
@@ -0,0 +1,20 @@
+package pkg
+
+import (
+	"golang.org/x/tools/go/analysis"
+)
+
+// checkFatalOutsideMain checks if a Fatal or Panic-level log call is made outside package main
+// and the allowed packages, and reports an issue if it is, since it takes the decision
+// to stop the process away from the caller.
+func checkFatalOutsideMain(pass *analysis.Pass, lc *logCall, allowedPackages []string) {
+	if lc.level != levelFatal && lc.level != levelPanic {
+		return
+	}
+	if pass.Pkg.Name() == "main" || matchesAnyPackagePattern(allowedPackages, pass.Pkg.Path()) {
+		return
+	}
+
+	pass.Reportf(lc.call.Pos(), "%s-level log call outside package main; return an error to the caller instead",
+		lc.level)
+}
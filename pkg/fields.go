@@ -0,0 +1,227 @@
+package pkg
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/token"
+	"go/types"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// libraryImportPath returns the import path of the package providing typed attributes for the library.
+func libraryImportPath(lib logLibrary) string {
+	switch lib {
+	case libSlog:
+		return "log/slog"
+	case libZap, libZapSugar:
+		return "go.uber.org/zap"
+	default:
+		return ""
+	}
+}
+
+// fieldConstructor returns the name of the typed attribute constructor of the library
+// (e.g., slog.String or zap.Int) suited for a value of the given type. Typed constructors are only
+// chosen for values of exactly their parameter type, so named types such as `type UserID int64`
+// and pointers get Any, which accepts them without a conversion.
+func fieldConstructor(lib logLibrary, t types.Type) string {
+	if t == nil {
+		return "Any"
+	}
+
+	if _, isPointer := t.(*types.Pointer); !isPointer {
+		switch {
+		case isNamedType(t, "time", "Duration"):
+			return "Duration"
+		case isNamedType(t, "time", "Time"):
+			return "Time"
+		}
+	}
+
+	if lib != libSlog && isErrorType(t) {
+		return "Error"
+	}
+
+	basic, ok := types.Unalias(t).(*types.Basic)
+	if !ok {
+		return "Any"
+	}
+
+	switch basic.Kind() {
+	case types.String, types.UntypedString:
+		return "String"
+	case types.Bool, types.UntypedBool:
+		return "Bool"
+	case types.Int, types.UntypedInt:
+		return "Int"
+	case types.Int64:
+		return "Int64"
+	case types.Uint64:
+		return "Uint64"
+	case types.Float64, types.UntypedFloat:
+		return "Float64"
+	}
+
+	if lib == libSlog {
+		return "Any"
+	}
+
+	switch basic.Kind() {
+	case types.Int32:
+		return "Int32"
+	case types.Uint:
+		return "Uint"
+	case types.Uint32:
+		return "Uint32"
+	case types.Float32:
+		return "Float32"
+	default:
+		return "Any"
+	}
+}
+
+// isErrorType reports whether t is or implements the error interface.
+func isErrorType(t types.Type) bool {
+	if t == nil {
+		return false
+	}
+	errType := types.Universe.Lookup("error").Type()
+	return types.Identical(t, errType) || types.Implements(t, errType.Underlying().(*types.Interface))
+}
+
+// fieldText builds the source of a typed attribute holding the value of expr,
+// e.g. `slog.String("user_name", user.Name)` or `zap.Error(err)`.
+func fieldText(pass *analysis.Pass, lib logLibrary, qualifier string, expr ast.Expr, key string) string {
+	constructor := fieldConstructor(lib, pass.TypesInfo.TypeOf(expr))
+	if constructor == "Error" {
+		return qualifier + ".Error(" + exprText(pass, expr) + ")"
+	}
+	return qualifier + "." + constructor + "(" + strconv.Quote(key) + ", " + exprText(pass, expr) + ")"
+}
+
+// fieldKey derives an attribute key from an argument expression,
+// so `user.ID` becomes "id" and `requestCount` becomes "request_count".
+func fieldKey(expr ast.Expr) string {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return toSnakeCase(e.Name)
+	case *ast.SelectorExpr:
+		return toSnakeCase(e.Sel.Name)
+	case *ast.CallExpr:
+		return fieldKey(e.Fun)
+	case *ast.StarExpr:
+		return fieldKey(e.X)
+	case *ast.UnaryExpr:
+		return fieldKey(e.X)
+	case *ast.ParenExpr:
+		return fieldKey(e.X)
+	case *ast.IndexExpr:
+		return fieldKey(e.X)
+	default:
+		return "value"
+	}
+}
+
+// splitIdentifier splits an identifier into its words at underscores,
+// dashes and camelCase boundaries, keeping acronyms together ("HTTPServer" -> "HTTP", "Server").
+func splitIdentifier(s string) []string {
+	var words []string
+	runes := []rune(s)
+	start := 0
+
+	flush := func(end int) {
+		if end > start {
+			words = append(words, string(runes[start:end]))
+		}
+		start = end
+	}
+
+	for i, r := range runes {
+		switch {
+		case r == '_' || r == '-':
+			flush(i)
+			start = i + 1
+		case i > start && unicode.IsUpper(r):
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				flush(i)
+			}
+		}
+	}
+	flush(len(runes))
+
+	return words
+}
+
+// toSnakeCase converts an identifier to snake_case.
+func toSnakeCase(s string) string {
+	words := splitIdentifier(s)
+	for i, w := range words {
+		words[i] = strings.ToLower(w)
+	}
+	if len(words) == 0 {
+		return "value"
+	}
+	return strings.Join(words, "_")
+}
+
+// exprText returns the source text of an expression.
+func exprText(pass *analysis.Pass, expr ast.Expr) string {
+	var buf bytes.Buffer
+	if err := format.Node(&buf, pass.Fset, expr); err != nil {
+		return types.ExprString(expr)
+	}
+	return buf.String()
+}
+
+// fileOf returns the file of the pass containing the given position.
+func fileOf(pass *analysis.Pass, pos token.Pos) *ast.File {
+	for _, file := range pass.Files {
+		if file.FileStart <= pos && pos <= file.FileEnd {
+			return file
+		}
+	}
+	return nil
+}
+
+// importName returns the name under which the file imports the package with the given path,
+// or an empty string if the package is not imported or is imported as blank or dot import.
+func importName(pass *analysis.Pass, file *ast.File, path string) string {
+	if file == nil {
+		return ""
+	}
+
+	for _, spec := range file.Imports {
+		importPath, err := strconv.Unquote(spec.Path.Value)
+		if err != nil || importPath != path {
+			continue
+		}
+		pkgName := pass.TypesInfo.PkgNameOf(spec)
+		if pkgName == nil || pkgName.Name() == "_" || pkgName.Name() == "." {
+			return ""
+		}
+		return pkgName.Name()
+	}
+	return ""
+}
+
+// isNamedType reports whether t, or the type it points to, is the named type pkgPath.name.
+func isNamedType(t types.Type, pkgPath, name string) bool {
+	if t == nil {
+		return false
+	}
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	named, ok := types.Unalias(t).(*types.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		return false
+	}
+	return named.Obj().Pkg().Path() == pkgPath && named.Obj().Name() == name
+}
@@ -0,0 +1,57 @@
+package pkg
+
+import (
+	"testing"
+)
+
+// TestToSnakeCase tests the toSnakeCase function
+// to ensure attribute keys are derived from identifiers consistently
+func TestToSnakeCase(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{
+			name: "lower",
+			in:   "name",
+			want: "name",
+		},
+		{
+			name: "camel_case",
+			in:   "requestCount",
+			want: "request_count",
+		},
+		{
+			name: "acronym_suffix",
+			in:   "userID",
+			want: "user_id",
+		},
+		{
+			name: "acronym_prefix",
+			in:   "HTTPStatus",
+			want: "http_status",
+		},
+		{
+			name: "snake_case",
+			in:   "api_key",
+			want: "api_key",
+		},
+		{
+			name: "digits",
+			in:   "retry2Count",
+			want: "retry2_count",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := toSnakeCase(tt.in)
+
+			if got != tt.want {
+				t.Errorf("expected %q, got %q: %s", tt.want, got, tt.name)
+			}
+		})
+	}
+}
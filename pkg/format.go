@@ -0,0 +1,160 @@
+package pkg
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// formatVerb is a single conversion of a printf-style format string.
+type formatVerb struct {
+	// text is the full conversion as written, e.g. "%-5.2f" or "%[2]*d".
+	text string
+	// verb is the conversion character, e.g. 'd' or 's'.
+	verb rune
+	// start and end are the byte offsets of the conversion in the format string.
+	start, end int
+	// starArgs are the indexes of arguments consumed by '*' width and precision.
+	starArgs []int
+	// argIndex is the index of the argument formatted by the verb, or -1 for "%%".
+	argIndex int
+	// badIndex reports a malformed explicit argument index such as "%[x]d".
+	badIndex bool
+}
+
+// parseFormatVerbs splits a printf-style format string into its conversions,
+// following the argument numbering rules of the fmt package.
+func parseFormatVerbs(format string) []formatVerb {
+	var verbs []formatVerb
+	argNum := 0
+
+	for i := 0; i < len(format); {
+		if format[i] != '%' {
+			i++
+			continue
+		}
+
+		v := formatVerb{start: i}
+		i++
+
+		for i < len(format) && strings.IndexByte("#0+- ", format[i]) >= 0 {
+			i++
+		}
+
+		i = parseArgIndex(format, i, &argNum, &v)
+		i = parseWidth(format, i, &argNum, &v)
+
+		if i < len(format) && format[i] == '.' {
+			i++
+			i = parseArgIndex(format, i, &argNum, &v)
+			i = parseWidth(format, i, &argNum, &v)
+		}
+
+		i = parseArgIndex(format, i, &argNum, &v)
+
+		if i >= len(format) {
+			v.end = i
+			v.text = format[v.start:v.end]
+			v.argIndex = argNum
+			argNum++
+			verbs = append(verbs, v)
+			break
+		}
+
+		r, size := utf8.DecodeRuneInString(format[i:])
+		i += size
+		v.verb = r
+		v.end = i
+		v.text = format[v.start:v.end]
+
+		if r == '%' {
+			v.argIndex = -1
+		} else {
+			v.argIndex = argNum
+			argNum++
+		}
+		verbs = append(verbs, v)
+	}
+
+	return verbs
+}
+
+// parseArgIndex consumes an explicit argument index like "[2]" and moves the argument counter to it.
+func parseArgIndex(format string, i int, argNum *int, v *formatVerb) int {
+	if i >= len(format) || format[i] != '[' {
+		return i
+	}
+
+	closing := strings.IndexByte(format[i:], ']')
+	if closing < 0 {
+		v.badIndex = true
+		return i
+	}
+
+	n, ok := parseNumber(format[i+1 : i+closing])
+	if !ok || n < 1 {
+		v.badIndex = true
+	} else {
+		*argNum = n - 1
+	}
+	return i + closing + 1
+}
+
+// parseWidth consumes a width or precision, either as digits or as '*' taking an argument.
+func parseWidth(format string, i int, argNum *int, v *formatVerb) int {
+	if i < len(format) && format[i] == '*' {
+		v.starArgs = append(v.starArgs, *argNum)
+		*argNum++
+		return i + 1
+	}
+	for i < len(format) && format[i] >= '0' && format[i] <= '9' {
+		i++
+	}
+	return i
+}
+
+// parseNumber parses a non-empty string of decimal digits.
+func parseNumber(s string) (int, bool) {
+	if s == "" {
+		return 0, false
+	}
+	n := 0
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return 0, false
+		}
+		n = n*10 + int(c-'0')
+	}
+	return n, true
+}
+
+// valueVerbs returns the conversions of the format string that format an argument, skipping "%%".
+func valueVerbs(format string) []formatVerb {
+	var verbs []formatVerb
+	for _, v := range parseFormatVerbs(format) {
+		if v.argIndex >= 0 {
+			verbs = append(verbs, v)
+		}
+	}
+	return verbs
+}
+
+// stripFormatVerbs removes the format verbs from a message, keeping the text around them,
+// so "user %s logged in" becomes "user logged in" and "took %dms" becomes "took ms".
+// A space left doubled, leading or trailing by a removed verb is dropped as well.
+func stripFormatVerbs(msg string) string {
+	var b strings.Builder
+	prev := 0
+	for _, v := range messageFormatVerbs(msg) {
+		b.WriteString(msg[prev:v.start])
+		prev = v.end
+		written := b.String()
+		if (written == "" || strings.HasSuffix(written, " ")) && prev < len(msg) && msg[prev] == ' ' {
+			prev++
+		}
+	}
+	if prev == len(msg) {
+		return strings.TrimSuffix(b.String(), " ")
+	}
+	b.WriteString(msg[prev:])
+	return b.String()
+}
@@ -0,0 +1,173 @@
+package pkg
+
+import (
+	"testing"
+)
+
+// TestMessageFormatVerbs tests the messageFormatVerbs function
+// to ensure it finds format verbs and ignores percent signs used in prose
+func TestMessageFormatVerbs(t *testing.T) {
+	tests := []struct {
+		name      string
+		msg       string
+		wantVerbs []string
+	}{
+		{
+			name:      "no_verbs",
+			msg:       "user logged in",
+			wantVerbs: nil,
+		},
+		{
+			name:      "single_verb",
+			msg:       "user %s logged in",
+			wantVerbs: []string{"%s"},
+		},
+		{
+			name:      "flags_and_width",
+			msg:       "took %-8.3fs for %[1]d items",
+			wantVerbs: []string{"%-8.3f", "%[1]d"},
+		},
+		{
+			name:      "escaped_percent",
+			msg:       "progress 50%%",
+			wantVerbs: nil,
+		},
+		{
+			name:      "percent_in_prose",
+			msg:       "disk is 100% full",
+			wantVerbs: nil,
+		},
+		{
+			name:      "trailing_percent",
+			msg:       "disk is 100%",
+			wantVerbs: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			verbs := messageFormatVerbs(tt.msg)
+
+			if len(verbs) != len(tt.wantVerbs) {
+				t.Fatalf("expected %d verbs, got %d: %s", len(tt.wantVerbs), len(verbs), tt.name)
+			}
+			for i, v := range verbs {
+				if v.text != tt.wantVerbs[i] {
+					t.Errorf("expected verb %q, got %q: %s", tt.wantVerbs[i], v.text, tt.name)
+				}
+			}
+		})
+	}
+}
+
+// TestStripFormatVerbs tests the stripFormatVerbs function
+// to ensure it drops the format verbs from a message and keeps the text around them
+func TestStripFormatVerbs(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  string
+		want string
+	}{
+		{
+			name: "verb_in_the_middle",
+			msg:  "user %s logged in",
+			want: "user logged in",
+		},
+		{
+			name: "verb_with_unit",
+			msg:  "took %dms",
+			want: "took ms",
+		},
+		{
+			name: "key_value_verb",
+			msg:  "request done status=%d",
+			want: "request done status=",
+		},
+		{
+			name: "verb_after_number",
+			msg:  "load 100%d",
+			want: "load 100",
+		},
+		{
+			name: "leading_verb",
+			msg:  "%s logged in",
+			want: "logged in",
+		},
+		{
+			name: "percent_sign_kept",
+			msg:  "disk 100% full after %s",
+			want: "disk 100% full after",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := stripFormatVerbs(tt.msg)
+
+			if got != tt.want {
+				t.Errorf("expected %q, got %q: %s", tt.want, got, tt.name)
+			}
+		})
+	}
+}
+
+// TestParseFormatVerbs tests the parseFormatVerbs function
+// to ensure arguments are numbered like in the fmt package
+func TestParseFormatVerbs(t *testing.T) {
+	tests := []struct {
+		name      string
+		format    string
+		wantArgs  []int
+		wantStars []int
+	}{
+		{
+			name:     "sequential",
+			format:   "%s took %d",
+			wantArgs: []int{0, 1},
+		},
+		{
+			name:     "escaped_percent",
+			format:   "%d%% done",
+			wantArgs: []int{0, -1},
+		},
+		{
+			name:     "explicit_index",
+			format:   "%[2]s %[1]d %s",
+			wantArgs: []int{1, 0, 1},
+		},
+		{
+			name:      "star_width",
+			format:    "%*d %.*f",
+			wantArgs:  []int{1, 3},
+			wantStars: []int{0, 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			verbs := parseFormatVerbs(tt.format)
+
+			if len(verbs) != len(tt.wantArgs) {
+				t.Fatalf("expected %d verbs, got %d: %s", len(tt.wantArgs), len(verbs), tt.name)
+			}
+			var stars []int
+			for i, v := range verbs {
+				if v.argIndex != tt.wantArgs[i] {
+					t.Errorf("expected arg %d for %q, got %d: %s", tt.wantArgs[i], v.text, v.argIndex, tt.name)
+				}
+				stars = append(stars, v.starArgs...)
+			}
+			if len(stars) != len(tt.wantStars) {
+				t.Fatalf("expected star args %v, got %v: %s", tt.wantStars, stars, tt.name)
+			}
+			for i := range stars {
+				if stars[i] != tt.wantStars[i] {
+					t.Errorf("expected star args %v, got %v: %s", tt.wantStars, stars, tt.name)
+				}
+			}
+		})
+	}
+}
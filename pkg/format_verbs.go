@@ -0,0 +1,158 @@
+package pkg
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// printfVerbs lists the conversion characters recognized by the fmt package.
+const printfVerbs = "vTtbcdoOqxXUeEfFgGspw"
+
+// messageFormatVerbs returns the format verbs found in a message of a method that does not format its arguments.
+// Conversions with a space flag are skipped, since "100% done" is far more likely prose than "% d".
+func messageFormatVerbs(msg string) []formatVerb {
+	var verbs []formatVerb
+	for _, v := range valueVerbs(msg) {
+		if v.verb == 0 || !strings.ContainsRune(printfVerbs, v.verb) || strings.Contains(v.text, " ") {
+			continue
+		}
+		verbs = append(verbs, v)
+	}
+	return verbs
+}
+
+// checkFormatVerbs checks if the message of a non-printf logging method contains format verbs,
+// which would be logged literally, and reports an issue if it does.
+func checkFormatVerbs(pass *analysis.Pass, lc *logCall) {
+	if lc.kind == kindPrintf {
+		return
+	}
+
+	lit, ok := lc.message().(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return
+	}
+	msg, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return
+	}
+
+	verbs := messageFormatVerbs(msg)
+	if len(verbs) == 0 {
+		return
+	}
+
+	diag := analysis.Diagnostic{
+		Pos:     lit.Pos(),
+		End:     lit.End(),
+		Message: "log message contains format verb " + verbs[0].text + ", but " + lc.sel.Sel.Name + " does not format its arguments",
+	}
+	if fix, ok := formatVerbsFix(pass, lc, lit, msg, len(verbs)); ok {
+		diag.SuggestedFixes = []analysis.SuggestedFix{fix}
+	}
+	pass.Report(diag)
+}
+
+// formatVerbsFix builds a fix moving the formatted arguments into typed attributes of the library,
+// or switching to the printf variant of the method when the library has no typed attributes.
+func formatVerbsFix(pass *analysis.Pass, lc *logCall, lit *ast.BasicLit, msg string, verbCount int) (analysis.SuggestedFix, bool) {
+	switch lc.lib {
+	case libSlog, libZap, libZapSugar:
+	default:
+		return printfVariantFix(lc)
+	}
+	if lc.lib == libZapSugar && lc.kind != kindPrint {
+		return analysis.SuggestedFix{}, false
+	}
+
+	edits := []analysis.TextEdit{{
+		Pos:     lit.Pos(),
+		End:     lit.End(),
+		NewText: []byte(strconv.Quote(stripFormatVerbs(msg))),
+	}}
+
+	args := lc.extraArgs()
+	if lc.kind == kindKV && startsKeyValuePairs(pass, args) {
+		return analysis.SuggestedFix{}, false
+	}
+	if verbCount < len(args) {
+		args = args[:verbCount]
+	}
+	if lc.lib != libZap && len(args) > 0 {
+		qualifier := importName(pass, fileOf(pass, lit.Pos()), libraryImportPath(lc.lib))
+		if qualifier == "" {
+			return analysis.SuggestedFix{}, false
+		}
+		keys := make(map[string]int)
+		for _, arg := range args {
+			if isFieldValue(pass, arg) {
+				return analysis.SuggestedFix{}, false
+			}
+			key := fieldKey(arg)
+			keys[key]++
+			if keys[key] > 1 {
+				key += "_" + strconv.Itoa(keys[key])
+			}
+			edits = append(edits, analysis.TextEdit{
+				Pos:     arg.Pos(),
+				End:     arg.End(),
+				NewText: []byte(fieldText(pass, lc.lib, qualifier, arg, key)),
+			})
+		}
+	}
+
+	if lc.lib == libZapSugar {
+		edits = append(edits, analysis.TextEdit{
+			Pos:     lc.sel.Sel.Pos(),
+			End:     lc.sel.Sel.End(),
+			NewText: []byte(lc.base + "w"),
+		})
+	}
+
+	return analysis.SuggestedFix{
+		Message:   "Move format arguments into typed attributes",
+		TextEdits: edits,
+	}, true
+}
+
+// printfVariantFix builds a fix renaming the method to its printf variant, e.g. Print to Printf.
+func printfVariantFix(lc *logCall) (analysis.SuggestedFix, bool) {
+	if _, ok := resolveMethod(lc.lib, lc.base+"f"); !ok {
+		return analysis.SuggestedFix{}, false
+	}
+	return analysis.SuggestedFix{
+		Message: "Use " + lc.base + "f instead",
+		TextEdits: []analysis.TextEdit{{
+			Pos:     lc.sel.Sel.Pos(),
+			End:     lc.sel.Sel.End(),
+			NewText: []byte(lc.base + "f"),
+		}},
+	}, true
+}
+
+// startsKeyValuePairs reports whether the arguments after the message already are key-value pairs
+// or attributes, so they are not the values of the format verbs: they start with a constant string
+// key followed by its value, or with a typed attribute.
+func startsKeyValuePairs(pass *analysis.Pass, args []ast.Expr) bool {
+	if len(args) == 0 {
+		return false
+	}
+	if isFieldValue(pass, args[0]) {
+		return true
+	}
+	tv, ok := pass.TypesInfo.Types[args[0]]
+	return ok && tv.Value != nil && tv.Value.Kind() == constant.String && len(args) > 1
+}
+
+// isFieldValue reports whether the expression already is a typed attribute (slog.Attr or zap.Field).
+func isFieldValue(pass *analysis.Pass, expr ast.Expr) bool {
+	t := pass.TypesInfo.TypeOf(expr)
+	return isNamedType(t, "log/slog", "Attr") ||
+		isNamedType(t, "go.uber.org/zap", "Field") ||
+		isNamedType(t, "go.uber.org/zap/zapcore", "Field")
+}
@@ -0,0 +1,51 @@
+package pkg
+
+import (
+	"go/ast"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// globalLoggerAccessors lists, per package, the functions returning the global logger
+// or logging through it, such as zap.L() or slog.Default().
+var globalLoggerAccessors = map[string][]string{
+	"log":                        {"Default"},
+	"log/slog":                   {"Default"},
+	"go.uber.org/zap":            {"L", "S"},
+	"github.com/sirupsen/logrus": {"StandardLogger", "WithField", "WithFields", "WithError", "WithContext"},
+}
+
+// checkGlobalLogger checks if a package-level logging function, such as slog.Info or log.Printf,
+// is called outside the allowed packages, and reports an issue if it is.
+func checkGlobalLogger(pass *analysis.Pass, lc *logCall, allowedPackages []string) {
+	if !lc.global || matchesAnyPackagePattern(allowedPackages, pass.Pkg.Path()) {
+		return
+	}
+	reportGlobalLogger(pass, lc.call, lc.sel)
+}
+
+// checkGlobalLoggerAccessor checks if a function returning the global logger, such as zap.L()
+// or slog.Default(), is called outside the allowed packages, and reports an issue if it is.
+func checkGlobalLoggerAccessor(pass *analysis.Pass, callExpr *ast.CallExpr, allowedPackages []string) {
+	selectorExpr, ok := callExpr.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return
+	}
+
+	accessors, ok := globalLoggerAccessors[selectorPackagePath(pass, selectorExpr)]
+	if !ok || matchesAnyPackagePattern(allowedPackages, pass.Pkg.Path()) {
+		return
+	}
+	for _, name := range accessors {
+		if selectorExpr.Sel.Name == name {
+			reportGlobalLogger(pass, callExpr, selectorExpr)
+			return
+		}
+	}
+}
+
+// reportGlobalLogger reports the use of a global logger.
+func reportGlobalLogger(pass *analysis.Pass, callExpr *ast.CallExpr, selectorExpr *ast.SelectorExpr) {
+	pass.Reportf(callExpr.Pos(), "use of global logger %s.%s; inject a logger instead",
+		exprText(pass, selectorExpr.X), selectorExpr.Sel.Name)
+}
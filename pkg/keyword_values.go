@@ -0,0 +1,191 @@
+package pkg
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"regexp"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// Sensitive keyword modes.
+const (
+	// keywordModeMention reports every sensitive keyword in a constant log message.
+	keywordModeMention = "mention"
+	// keywordModeValue reports sensitive keywords only when a value is attached to them,
+	// including a literal value in the message itself, which checkNoSensitiveData reports.
+	keywordModeValue = "value"
+)
+
+var (
+	// attachedValueSuffix matches the text between a sensitive keyword and a value concatenated
+	// after it, such as ": " in "password: " + pwd.
+	attachedValueSuffix = regexp.MustCompile(`^['"]?\s*[:=]?\s*['"]?$`)
+
+	// attachedVerbSuffix matches the text between a sensitive keyword and a format verb
+	// formatting its value, such as "=%" in "token=%s".
+	attachedVerbSuffix = regexp.MustCompile(`^['"]?\s*[:=]?\s*['"]?%[^%]`)
+)
+
+// checkSensitiveKeywordValues checks if a value is attached to a sensitive keyword: concatenated
+// after it ("password: " + pwd), formatted after it ("token=%s") or passed under a field key containing
+// it ("password", pwd). Bare mentions, such as "password is incorrect", and values already passed
+// through the redaction helper are not reported; the values attached to a keyword have high confidence.
+// The values come with a fix passing them through the redaction helper, importing its package if needed;
+// fields are removed instead when no helper is configured.
+func checkSensitiveKeywordValues(pass *analysis.Pass, lc *logCall, m *sensitiveMatcher, helper *redactionHelper) {
+	checkAttachedKeywords(pass, lc, m, helper)
+	if lc.kind == kindKV || lc.kind == kindFields {
+		checkSensitiveFieldKeys(pass, lc, m, helper)
+	}
+}
+
+// checkAttachedKeywords checks the constant parts of the log message, and of the arguments of print-style
+// calls, for sensitive keywords followed by a non-constant value or, in printf-style calls, a format verb.
+func checkAttachedKeywords(pass *analysis.Pass, lc *logCall, m *sensitiveMatcher, helper *redactionHelper) {
+	msg := lc.message()
+	if msg == nil {
+		return
+	}
+	operands := concatOperands(pass, msg)
+	if lc.kind == kindPrint {
+		operands = append(operands, lc.extraArgs()...)
+	}
+
+	for i, operand := range operands {
+		s, ok := constantString(pass, operand)
+		if !ok {
+			continue
+		}
+		var next ast.Expr
+		if i+1 < len(operands) && pass.TypesInfo.Types[operands[i+1]].Value == nil {
+			next = operands[i+1]
+		}
+		for _, match := range m.findKeywords(s) {
+			rest := s[match.end:]
+			var value ast.Expr
+			switch {
+			case next != nil && attachedValueSuffix.MatchString(rest):
+				if helper.redacts(pass, next) {
+					continue
+				}
+				value = next
+			case lc.kind == kindPrintf && attachedVerbSuffix.MatchString(rest):
+				if len(operands) == 1 {
+					value = formattedArg(lc.call, lc.msgIndex, s, match.end)
+				}
+				if value != nil && helper.redacts(pass, value) {
+					continue
+				}
+			default:
+				continue
+			}
+
+			diag := analysis.Diagnostic{
+				Pos:     operand.Pos(),
+				End:     operand.End(),
+				Message: fmt.Sprintf("log message attaches a value to sensitive keyword %q", match.keyword),
+			}
+			if lit, ok := operand.(*ast.BasicLit); ok {
+				if p, e, ok := literalRange(lit, match.start, match.end); ok {
+					diag.Pos, diag.End = p, e
+				}
+			}
+			if value != nil {
+				if fix, ok := wrapValueFix(pass, value, helper); ok {
+					diag.SuggestedFixes = []analysis.SuggestedFix{fix}
+				}
+			}
+			m.report(pass, diag, confidenceHigh)
+			break
+		}
+	}
+}
+
+// formattedArg returns the argument formatted by the first verb of the format, the call argument
+// at fmtIndex, at or after offset, or nil if the call has no such argument.
+func formattedArg(call *ast.CallExpr, fmtIndex int, format string, offset int) ast.Expr {
+	for _, v := range valueVerbs(format) {
+		if v.start < offset {
+			continue
+		}
+		if i := fmtIndex + 1 + v.argIndex; i < len(call.Args) {
+			return call.Args[i]
+		}
+		return nil
+	}
+	return nil
+}
+
+// attachedArgs returns the arguments of a printf-style call that checkAttachedKeywords reports
+// with the sensitive keyword of the format they are attached to, as token in ("token=%s", token).
+func attachedArgs(pass *analysis.Pass, call *ast.CallExpr, fmtIndex int, m *sensitiveMatcher) map[ast.Expr]bool {
+	format, ok := constantString(pass, call.Args[fmtIndex])
+	if !ok {
+		return nil
+	}
+	attached := make(map[ast.Expr]bool)
+	for _, match := range m.findKeywords(format) {
+		if !attachedVerbSuffix.MatchString(format[match.end:]) {
+			continue
+		}
+		if arg := formattedArg(call, fmtIndex, format, match.end); arg != nil {
+			attached[arg] = true
+		}
+	}
+	return attached
+}
+
+// checkSensitiveFieldKeys checks the constant keys of the key-value pairs and typed fields
+// passed to the log call for sensitive keywords.
+func checkSensitiveFieldKeys(pass *analysis.Pass, lc *logCall, m *sensitiveMatcher, helper *redactionHelper) {
+	args := lc.call.Args
+	for i := lc.msgIndex + 1; i < len(args); i++ {
+		first := i
+		var key, value ast.Expr
+		if isFieldValue(pass, args[i]) {
+			call, ok := ast.Unparen(args[i]).(*ast.CallExpr)
+			if !ok || len(call.Args) < 2 {
+				continue
+			}
+			key, value = call.Args[0], call.Args[1]
+		} else if lc.kind == kindKV && i+1 < len(args) {
+			key, value = args[i], args[i+1]
+			i++
+		} else {
+			continue
+		}
+
+		s, ok := constantString(pass, key)
+		if !ok || helper.redacts(pass, value) {
+			continue
+		}
+		match, ok := m.findKeyword(s)
+		if !ok {
+			continue
+		}
+		diag := analysis.Diagnostic{
+			Pos:     key.Pos(),
+			Message: fmt.Sprintf("log field %q contains sensitive keyword %q", s, match.keyword),
+		}
+		if fix, ok := wrapValueFix(pass, value, helper); ok {
+			diag.SuggestedFixes = []analysis.SuggestedFix{fix}
+		} else if helper == nil {
+			diag.SuggestedFixes = []analysis.SuggestedFix{removeArgsFix(lc, first, i)}
+		}
+		m.report(pass, diag, confidenceHigh)
+	}
+}
+
+// concatOperands returns the operands of a string concatenation, keeping constant subexpressions,
+// which the compiler folds, whole.
+func concatOperands(pass *analysis.Pass, expr ast.Expr) []ast.Expr {
+	if _, ok := constantString(pass, expr); ok {
+		return []ast.Expr{expr}
+	}
+	if bin, ok := ast.Unparen(expr).(*ast.BinaryExpr); ok && bin.Op == token.ADD {
+		return append(concatOperands(pass, bin.X), concatOperands(pass, bin.Y)...)
+	}
+	return []ast.Expr{expr}
+}
@@ -0,0 +1,101 @@
+package pkg
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// identSegment is a word of a message split at spaces, punctuation, underscores, dashes
+// and camelCase boundaries, with its byte offsets and the index of the token it belongs to.
+type identSegment struct {
+	text       string
+	start, end int
+	token      int
+}
+
+// identifierSegments splits s into lowercase segments, so "userPassword", "user_password"
+// and "user-password" all yield "user" and "password" within one token.
+func identifierSegments(s string) []identSegment {
+	var segments []identSegment
+	token, start := 0, -1
+	var prev rune
+
+	flush := func(end int) {
+		if start >= 0 && end > start {
+			segments = append(segments, identSegment{text: strings.ToLower(s[start:end]), start: start, end: end, token: token})
+		}
+		start = -1
+	}
+
+	for i, r := range s {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if start >= 0 && unicode.IsUpper(r) {
+				next, _ := utf8.DecodeRuneInString(s[i+utf8.RuneLen(r):])
+				if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && unicode.IsLower(next)) {
+					flush(i)
+				}
+			}
+			if start < 0 {
+				start = i
+			}
+		case r == '_' || r == '-':
+			flush(i)
+		default:
+			flush(i)
+			token++
+		}
+		prev = r
+	}
+	flush(len(s))
+	return segments
+}
+
+// keywordSegments splits a keyword such as "api_key" or "apiKey" into its lowercase segments.
+func keywordSegments(keyword string) []string {
+	segments := identifierSegments(keyword)
+	texts := make([]string, 0, len(segments))
+	for _, segment := range segments {
+		texts = append(texts, segment.text)
+	}
+	return texts
+}
+
+// keywordMatch is an occurrence of a sensitive keyword in a message.
+type keywordMatch struct {
+	keyword    string
+	start, end int
+}
+
+// Separators of the segment text; they never occur in segments, which consist of letters and digits.
+const (
+	segmentSeparator = "\x1f"
+	tokenSeparator   = "\x1e"
+)
+
+// segmentText joins the segments into the text the keyword automaton runs over, and returns it
+// with the offset of each segment in it. Segments are delimited by segmentSeparator, and tokens
+// are further delimited by tokenSeparator, so "user_password token" becomes
+// "\x1fuser\x1fpassword\x1f\x1e\x1ftoken\x1f".
+func segmentText(segments []identSegment) (string, []int) {
+	var b strings.Builder
+	offsets := make([]int, len(segments))
+	b.WriteString(segmentSeparator)
+	for i, segment := range segments {
+		if i > 0 && segment.token != segments[i-1].token {
+			b.WriteString(tokenSeparator + segmentSeparator)
+		}
+		offsets[i] = b.Len()
+		b.WriteString(segment.text)
+		b.WriteString(segmentSeparator)
+	}
+	return b.String(), offsets
+}
+
+// keywordPattern returns the pattern of the keyword automaton for the keyword segments, which matches
+// them as whole consecutive segments of one token of a segment text, so "token" matches "access_token"
+// and "tokenValue" but not "tokenizer".
+func keywordPattern(kwSegments []string) string {
+	return segmentSeparator + strings.Join(kwSegments, segmentSeparator) + segmentSeparator
+}
@@ -0,0 +1,41 @@
+package pkg
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+// TestIdentifierSegments tests the identifierSegments function
+// to ensure messages are split at identifier boundaries with correct offsets
+func TestIdentifierSegments(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  string
+		want []string
+	}{
+		{name: "words", msg: "invalid token", want: []string{"invalid", "token"}},
+		{name: "snake_case", msg: "api_key missing", want: []string{"api", "key", "missing"}},
+		{name: "camel_case", msg: "userPassword", want: []string{"user", "password"}},
+		{name: "acronym", msg: "APIKey", want: []string{"api", "key"}},
+		{name: "kebab_case", msg: "x-auth-token", want: []string{"x", "auth", "token"}},
+		{name: "punctuation", msg: "token: abc.", want: []string{"token", "abc"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var got []string
+			for _, segment := range identifierSegments(tt.msg) {
+				if want := tt.msg[segment.start:segment.end]; !strings.EqualFold(want, segment.text) {
+					t.Errorf("segment %q has offsets of %q", segment.text, want)
+				}
+				got = append(got, segment.text)
+			}
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("expected %v, got %v: %s", tt.want, got, tt.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package pkg
+
+import (
+	"go/ast"
+	"go/types"
+	"path"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// checkLogAndReturn checks if an error logged by the call is also returned, possibly wrapped,
+// by the statements that follow it in the same branch, and reports the double handling.
+func checkLogAndReturn(pass *analysis.Pass, lc *logCall, stack []ast.Node, allowedFuncs []string) {
+	if isAllowedFunc(enclosingFuncName(stack), allowedFuncs) {
+		return
+	}
+
+	stmts, index := enclosingStmtList(stack)
+	if stmts == nil {
+		return
+	}
+
+	loggedErrs := errorObjects(pass, lc.call)
+	if len(loggedErrs) == 0 {
+		return
+	}
+
+	for _, stmt := range stmts[index+1:] {
+		ret, ok := stmt.(*ast.ReturnStmt)
+		if !ok {
+			if isBranchingStmt(stmt) {
+				return
+			}
+			continue
+		}
+
+		for _, obj := range loggedErrs {
+			for _, result := range ret.Results {
+				if usesObject(pass, result, obj) {
+					pass.Reportf(lc.call.Pos(), "error %s is logged and also returned at line %d; handle it once",
+						obj.Name(), pass.Fset.Position(ret.Pos()).Line)
+					return
+				}
+			}
+		}
+		return
+	}
+}
+
+// errorObjects returns the variables of error type referenced by the log call.
+func errorObjects(pass *analysis.Pass, call *ast.CallExpr) []types.Object {
+	var objs []types.Object
+	seen := make(map[types.Object]bool)
+
+	ast.Inspect(call, func(n ast.Node) bool {
+		ident, ok := n.(*ast.Ident)
+		if !ok {
+			return true
+		}
+		v, ok := pass.TypesInfo.Uses[ident].(*types.Var)
+		if !ok || seen[v] || v.IsField() || !isErrorType(v.Type()) {
+			return true
+		}
+		seen[v] = true
+		objs = append(objs, v)
+		return true
+	})
+	return objs
+}
+
+// enclosingStmtList returns the statement list holding the statement that contains the current node,
+// together with the index of that statement, or nil if the node is not inside a statement list.
+func enclosingStmtList(stack []ast.Node) ([]ast.Stmt, int) {
+	for i := len(stack) - 2; i >= 0; i-- {
+		var list []ast.Stmt
+		switch node := stack[i].(type) {
+		case *ast.FuncDecl, *ast.FuncLit:
+			return nil, 0
+		case *ast.BlockStmt:
+			list = node.List
+		case *ast.CaseClause:
+			list = node.Body
+		case *ast.CommClause:
+			list = node.Body
+		default:
+			continue
+		}
+
+		for j, stmt := range list {
+			if stmt == stack[i+1] {
+				return list, j
+			}
+		}
+	}
+	return nil, 0
+}
+
+// isBranchingStmt reports whether the statement may transfer control elsewhere,
+// so the statements after it are not known to run on the same path.
+func isBranchingStmt(stmt ast.Stmt) bool {
+	switch stmt.(type) {
+	case *ast.BranchStmt, *ast.IfStmt, *ast.ForStmt, *ast.RangeStmt,
+		*ast.SwitchStmt, *ast.TypeSwitchStmt, *ast.SelectStmt, *ast.GoStmt, *ast.LabeledStmt:
+		return true
+	default:
+		return false
+	}
+}
+
+// enclosingFuncName returns the name of the function declaration containing the current node,
+// as "Func" or "Type.Method", or an empty string outside of function declarations.
+func enclosingFuncName(stack []ast.Node) string {
+	for i := len(stack) - 1; i >= 0; i-- {
+		decl, ok := stack[i].(*ast.FuncDecl)
+		if !ok {
+			continue
+		}
+		if decl.Recv == nil || len(decl.Recv.List) == 0 {
+			return decl.Name.Name
+		}
+		return receiverTypeName(decl.Recv.List[0].Type) + "." + decl.Name.Name
+	}
+	return ""
+}
+
+// receiverTypeName returns the base type name of a method receiver, dropping pointers and type parameters.
+func receiverTypeName(expr ast.Expr) string {
+	switch e := expr.(type) {
+	case *ast.StarExpr:
+		return receiverTypeName(e.X)
+	case *ast.IndexExpr:
+		return receiverTypeName(e.X)
+	case *ast.IndexListExpr:
+		return receiverTypeName(e.X)
+	case *ast.Ident:
+		return e.Name
+	default:
+		return ""
+	}
+}
+
+// isAllowedFunc reports whether the function name matches one of the glob patterns.
+func isAllowedFunc(name string, patterns []string) bool {
+	if name == "" {
+		return false
+	}
+	for _, pattern := range patterns {
+		if matched, err := path.Match(pattern, name); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,264 @@
+package pkg
+
+import (
+	"go/ast"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// logLibrary identifies the logging library a call belongs to.
+type logLibrary int
+
+const (
+	libUnknown logLibrary = iota
+	libStdLog
+	libSlog
+	libZap
+	libZapSugar
+	libLogrus
+	libKlog
+)
+
+// logLevel is the severity of a log call, derived from the called method.
+type logLevel int
+
+const (
+	levelUnknown logLevel = iota
+	levelDebug
+	levelInfo
+	levelWarn
+	levelError
+	levelFatal
+	levelPanic
+)
+
+// String returns the level name as used in diagnostics, e.g. "Error".
+func (l logLevel) String() string {
+	switch l {
+	case levelDebug:
+		return "Debug"
+	case levelInfo:
+		return "Info"
+	case levelWarn:
+		return "Warn"
+	case levelError:
+		return "Error"
+	case levelFatal:
+		return "Fatal"
+	case levelPanic:
+		return "Panic"
+	default:
+		return "Unknown"
+	}
+}
+
+// methodKind describes how a logging method treats the arguments following the message.
+type methodKind int
+
+const (
+	// kindPrint concatenates all arguments, like fmt.Sprint (log.Print, sugar.Info).
+	kindPrint methodKind = iota
+	// kindPrintf formats the arguments with a template, like fmt.Sprintf (log.Printf, sugar.Infof).
+	kindPrintf
+	// kindKV takes a message followed by key-value pairs or attributes (slog.Info, sugar.Infow).
+	kindKV
+	// kindFields takes a message followed by typed fields (zap.Logger.Info).
+	kindFields
+)
+
+// logCall is a call of a supported logging method together with what is known about it.
+type logCall struct {
+	call     *ast.CallExpr
+	sel      *ast.SelectorExpr
+	lib      logLibrary
+	level    logLevel
+	kind     methodKind
+	base     string
+	msgIndex int
+	context  bool
+	global   bool
+}
+
+// methodSpec describes a logging method name split into its level part and variant suffix.
+type methodSpec struct {
+	base     string
+	suffix   string
+	kind     methodKind
+	msgIndex int
+	context  bool
+}
+
+// parseLevel parses a level name from the config, such as "info" or "Error".
+func parseLevel(name string) (logLevel, bool) {
+	for l := levelDebug; l <= levelPanic; l++ {
+		if strings.EqualFold(name, l.String()) {
+			return l, true
+		}
+	}
+	return levelUnknown, false
+}
+
+// levelByBase maps the level part of a method name to the log level.
+var levelByBase = map[string]logLevel{
+	"Trace":   levelDebug,
+	"Debug":   levelDebug,
+	"Info":    levelInfo,
+	"Print":   levelInfo,
+	"Warn":    levelWarn,
+	"Warning": levelWarn,
+	"Error":   levelError,
+	"DPanic":  levelError,
+	"Fatal":   levelFatal,
+	"Exit":    levelFatal,
+	"Panic":   levelPanic,
+}
+
+// libraryMethods lists, per library, the level parts and variant suffixes that form logging methods,
+// and the methods whose arguments deviate from their variant.
+var libraryMethods = map[logLibrary]struct {
+	bases     []string
+	suffixes  map[string]methodSpec
+	overrides map[string]methodSpec
+}{
+	libStdLog: {
+		bases: []string{"Print", "Fatal", "Panic"},
+		suffixes: map[string]methodSpec{
+			"":   {kind: kindPrint},
+			"f":  {kind: kindPrintf},
+			"ln": {kind: kindPrint},
+		},
+	},
+	libSlog: {
+		bases: []string{"Debug", "Info", "Warn", "Error"},
+		suffixes: map[string]methodSpec{
+			"":        {kind: kindKV},
+			"Context": {kind: kindKV, msgIndex: 1, context: true},
+		},
+	},
+	libZap: {
+		bases: []string{"Debug", "Info", "Warn", "Error", "DPanic", "Panic", "Fatal"},
+		suffixes: map[string]methodSpec{
+			"": {kind: kindFields},
+		},
+	},
+	libZapSugar: {
+		bases: []string{"Debug", "Info", "Warn", "Error", "DPanic", "Panic", "Fatal"},
+		suffixes: map[string]methodSpec{
+			"":   {kind: kindPrint},
+			"f":  {kind: kindPrintf},
+			"w":  {kind: kindKV},
+			"ln": {kind: kindPrint},
+		},
+	},
+	libLogrus: {
+		bases: []string{"Trace", "Debug", "Info", "Print", "Warn", "Warning", "Error", "Fatal", "Panic"},
+		suffixes: map[string]methodSpec{
+			"":   {kind: kindPrint},
+			"f":  {kind: kindPrintf},
+			"ln": {kind: kindPrint},
+		},
+	},
+	libKlog: {
+		bases: []string{"Info", "Warning", "Error", "Fatal", "Exit"},
+		suffixes: map[string]methodSpec{
+			"":   {kind: kindPrint},
+			"f":  {kind: kindPrintf},
+			"ln": {kind: kindPrint},
+			"S":  {kind: kindKV},
+		},
+		overrides: map[string]methodSpec{
+			"ErrorS": {kind: kindKV, msgIndex: 1},
+		},
+	},
+}
+
+// resolveMethod splits the method name into a level part and a variant suffix
+// known for the given library.
+func resolveMethod(lib logLibrary, name string) (methodSpec, bool) {
+	methods, ok := libraryMethods[lib]
+	if !ok {
+		return methodSpec{}, false
+	}
+
+	for _, base := range methods.bases {
+		suffix, found := strings.CutPrefix(name, base)
+		if !found {
+			continue
+		}
+		spec, known := methods.suffixes[suffix]
+		if override, ok := methods.overrides[name]; ok {
+			spec, known = override, true
+		}
+		if !known {
+			continue
+		}
+		spec.base = base
+		spec.suffix = suffix
+		return spec, true
+	}
+	return methodSpec{}, false
+}
+
+// parseLogCall recognizes a call of a supported logging method and describes it.
+func parseLogCall(pass *analysis.Pass, callExpr *ast.CallExpr) (*logCall, bool) {
+	selectorExpr, ok := callExpr.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return nil, false
+	}
+
+	var lib logLibrary
+	global := isStdLoggerSelector(pass, selectorExpr)
+	if global {
+		lib = libraryByPackage(selectorPackagePath(pass, selectorExpr))
+	} else {
+		lib = loggerTypeLibrary(pass, selectorExpr.X)
+	}
+	if lib == libUnknown {
+		return nil, false
+	}
+
+	spec, ok := resolveMethod(lib, selectorExpr.Sel.Name)
+	if !ok {
+		return nil, false
+	}
+
+	return &logCall{
+		call:     callExpr,
+		sel:      selectorExpr,
+		lib:      lib,
+		level:    levelByBase[spec.base],
+		kind:     spec.kind,
+		base:     spec.base,
+		msgIndex: spec.msgIndex,
+		context:  spec.context,
+		global:   global,
+	}, true
+}
+
+// baselineScope reports whether the call belongs to a library checked by the baseline message rules
+// (lowercase start, English only, special characters, sensitive keywords), which cover slog and zap only.
+func (lc *logCall) baselineScope() bool {
+	switch lc.lib {
+	case libSlog, libZap, libZapSugar:
+		return true
+	default:
+		return false
+	}
+}
+
+// message returns the expression holding the log message, or nil if the call has none.
+func (lc *logCall) message() ast.Expr {
+	if lc.msgIndex >= len(lc.call.Args) {
+		return nil
+	}
+	return lc.call.Args[lc.msgIndex]
+}
+
+// extraArgs returns the arguments following the log message.
+func (lc *logCall) extraArgs() []ast.Expr {
+	if lc.msgIndex+1 >= len(lc.call.Args) {
+		return nil
+	}
+	return lc.call.Args[lc.msgIndex+1:]
+}
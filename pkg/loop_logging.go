@@ -0,0 +1,97 @@
+package pkg
+
+import (
+	"go/ast"
+	"slices"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// levelGuardMethods lists the methods whose calls in an if statement guard logging by level,
+// such as slog's logger.Enabled(ctx, lvl), zap's logger.Check(lvl, msg) and klog's V(n).
+var levelGuardMethods = []string{"Enabled", "Check", "V"}
+
+// checkLoopLogging checks if a log call at or above the minimum level is made lexically inside a loop
+// body without a level guard or a sampling wrapper, and reports an issue if it is.
+func checkLoopLogging(pass *analysis.Pass, lc *logCall, stack []ast.Node, minLevel logLevel, samplingFuncs []string) {
+	if lc.level < minLevel || lc.lib == libKlog && !lc.global {
+		return
+	}
+
+	for i := len(stack) - 2; i >= 0; i-- {
+		switch node := stack[i].(type) {
+		case *ast.FuncDecl:
+			return
+		case *ast.FuncLit:
+			if i > 0 {
+				if call, ok := stack[i-1].(*ast.CallExpr); ok && isSamplingCall(pass, call, samplingFuncs) {
+					return
+				}
+			}
+		case *ast.IfStmt:
+			if stack[i+1] == node.Body && (isLoggingGuard(pass, node.Init, samplingFuncs) || isLoggingGuard(pass, node.Cond, samplingFuncs)) {
+				return
+			}
+		case *ast.ForStmt:
+			if stack[i+1] == node.Body {
+				reportLoopLogging(pass, lc)
+				return
+			}
+		case *ast.RangeStmt:
+			if stack[i+1] == node.Body {
+				reportLoopLogging(pass, lc)
+				return
+			}
+		}
+	}
+}
+
+// isLoggingGuard reports whether the node calls a level check or a sampling function.
+func isLoggingGuard(pass *analysis.Pass, node ast.Node, samplingFuncs []string) bool {
+	if node == nil {
+		return false
+	}
+
+	found := false
+	ast.Inspect(node, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || found {
+			return !found
+		}
+		if isLevelGuardCall(pass, call) {
+			found = true
+		}
+		if isSamplingCall(pass, call, samplingFuncs) {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// isLevelGuardCall reports whether the call is a level guard method of a supported logger,
+// rather than an unrelated method sharing its name, such as a validator's Check.
+func isLevelGuardCall(pass *analysis.Pass, call *ast.CallExpr) bool {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || !slices.Contains(levelGuardMethods, sel.Sel.Name) {
+		return false
+	}
+	if sel.Sel.Name == "V" {
+		return loggerTypeLibrary(pass, call) == libKlog
+	}
+	return loggerTypeLibrary(pass, sel.X) != libUnknown
+}
+
+// isSamplingCall reports whether the call is one of the configured sampling functions.
+func isSamplingCall(pass *analysis.Pass, call *ast.CallExpr, samplingFuncs []string) bool {
+	if len(samplingFuncs) == 0 {
+		return false
+	}
+	_, name := calleeFunc(pass, call)
+	return name != "" && slices.Contains(samplingFuncs, name)
+}
+
+// reportLoopLogging reports a log call fired once per loop iteration.
+func reportLoopLogging(pass *analysis.Pass, lc *logCall) {
+	pass.Reportf(lc.call.Pos(), "%s-level log call inside a loop; guard it with a level check or sampling", lc.level)
+}
@@ -0,0 +1,99 @@
+package pkg
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// compiledMessagePatterns holds the compiled regexes of MessagePatterns.
+type compiledMessagePatterns struct {
+	mustMatch    []*regexp.Regexp
+	mustNotMatch []*regexp.Regexp
+}
+
+// compileMessagePatterns parses the levels and compiles the regexes of the message patterns.
+func compileMessagePatterns(patterns map[string]MessagePatterns) (map[logLevel]compiledMessagePatterns, error) {
+	compiled := make(map[logLevel]compiledMessagePatterns, len(patterns))
+	for name, mp := range patterns {
+		level, ok := parseLevel(name)
+		if !ok {
+			return nil, fmt.Errorf("message_patterns: unknown level %q", name)
+		}
+
+		var cmp compiledMessagePatterns
+		var err error
+		if cmp.mustMatch, err = compileRegexps(mp.MustMatch); err != nil {
+			return nil, fmt.Errorf("message_patterns.%s.must_match: %w", name, err)
+		}
+		if cmp.mustNotMatch, err = compileRegexps(mp.MustNotMatch); err != nil {
+			return nil, fmt.Errorf("message_patterns.%s.must_not_match: %w", name, err)
+		}
+		compiled[level] = cmp
+	}
+	return compiled, nil
+}
+
+// compileRegexps compiles a list of regexes.
+func compileRegexps(exprs []string) ([]*regexp.Regexp, error) {
+	regexps := make([]*regexp.Regexp, 0, len(exprs))
+	for _, expr := range exprs {
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, err
+		}
+		regexps = append(regexps, re)
+	}
+	return regexps, nil
+}
+
+// checkMessagePatterns checks if the constant message of a log call matches one of the MustMatch
+// regexes of its level and none of the MustNotMatch ones, and reports the expected pattern otherwise.
+func checkMessagePatterns(pass *analysis.Pass, lc *logCall, patterns map[logLevel]compiledMessagePatterns) {
+	cmp, ok := patterns[lc.level]
+	if !ok {
+		return
+	}
+	msgExpr := lc.message()
+	if msgExpr == nil {
+		return
+	}
+	msg, ok := constantString(pass, msgExpr)
+	if !ok {
+		return
+	}
+
+	if len(cmp.mustMatch) > 0 && !matchesAnyRegexp(cmp.mustMatch, msg) {
+		pass.Reportf(msgExpr.Pos(), "%s-level log message %q should match %s",
+			lc.level, msg, formatRegexps(cmp.mustMatch))
+	}
+	for _, re := range cmp.mustNotMatch {
+		if re.MatchString(msg) {
+			pass.Reportf(msgExpr.Pos(), "%s-level log message %q should not match `%s`", lc.level, msg, re)
+		}
+	}
+}
+
+// matchesAnyRegexp reports whether s matches one of the regexes.
+func matchesAnyRegexp(regexps []*regexp.Regexp, s string) bool {
+	for _, re := range regexps {
+		if re.MatchString(s) {
+			return true
+		}
+	}
+	return false
+}
+
+// formatRegexps lists the regexes for a diagnostic, as "`a`" or "one of `a`, `b`".
+func formatRegexps(regexps []*regexp.Regexp) string {
+	quoted := make([]string, 0, len(regexps))
+	for _, re := range regexps {
+		quoted = append(quoted, "`"+re.String()+"`")
+	}
+	if len(quoted) == 1 {
+		return quoted[0]
+	}
+	return "one of " + strings.Join(quoted, ", ")
+}
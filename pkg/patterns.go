@@ -0,0 +1,40 @@
+package pkg
+
+import (
+	"path"
+	"strings"
+)
+
+// matchesPackagePattern reports whether the package path matches the pattern.
+// Patterns are path.Match globs compared against the whole path or any of its trailing
+// segments, so "internal/bootstrap" matches "example.com/app/internal/bootstrap".
+// A trailing "/..." matches the package and all packages below it, as in "cmd/...".
+func matchesPackagePattern(pattern, pkgPath string) bool {
+	segments := strings.Split(pkgPath, "/")
+	prefix, recursive := strings.CutSuffix(pattern, "/...")
+	patternLen := len(strings.Split(prefix, "/"))
+
+	for i := range segments {
+		candidate := segments[i:]
+		if recursive {
+			if len(candidate) < patternLen {
+				continue
+			}
+			candidate = candidate[:patternLen]
+		}
+		if matched, err := path.Match(prefix, strings.Join(candidate, "/")); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesAnyPackagePattern reports whether the package path matches one of the patterns.
+func matchesAnyPackagePattern(patterns []string, pkgPath string) bool {
+	for _, pattern := range patterns {
+		if matchesPackagePattern(pattern, pkgPath) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,70 @@
+package pkg
+
+import (
+	"testing"
+)
+
+// TestMatchesPackagePattern tests the matchesPackagePattern function
+// to ensure package path globs match whole paths and trailing segments
+func TestMatchesPackagePattern(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+		pkgPath string
+		want    bool
+	}{
+		{
+			name:    "exact_path",
+			pattern: "example.com/app/internal/bootstrap",
+			pkgPath: "example.com/app/internal/bootstrap",
+			want:    true,
+		},
+		{
+			name:    "trailing_segments",
+			pattern: "internal/bootstrap",
+			pkgPath: "example.com/app/internal/bootstrap",
+			want:    true,
+		},
+		{
+			name:    "partial_segment",
+			pattern: "bootstrap",
+			pkgPath: "example.com/app/internal/nobootstrap",
+			want:    false,
+		},
+		{
+			name:    "recursive_root",
+			pattern: "cmd/...",
+			pkgPath: "example.com/app/cmd",
+			want:    true,
+		},
+		{
+			name:    "recursive_child",
+			pattern: "cmd/...",
+			pkgPath: "example.com/app/cmd/server/http",
+			want:    true,
+		},
+		{
+			name:    "recursive_other",
+			pattern: "cmd/...",
+			pkgPath: "example.com/app/internal/server",
+			want:    false,
+		},
+		{
+			name:    "glob",
+			pattern: "internal/*/billing",
+			pkgPath: "example.com/app/internal/eu/billing",
+			want:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := matchesPackagePattern(tt.pattern, tt.pkgPath)
+
+			if got != tt.want {
+				t.Errorf("expected %v, got %v: %s", tt.want, got, tt.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package pkg
+
+import (
+	"net/netip"
+	"regexp"
+	"strings"
+)
+
+// PII detectors, validated beyond their regex where a checksum or parser is available.
+var (
+	cardNumberDetector = sensitiveDetector{
+		name:       "credit card number",
+		confidence: confidenceMedium,
+		pattern:    regexp.MustCompile(`\b\d(?:[ -]?\d){12,18}\b`),
+		validate:   isLuhnValid,
+	}
+	ibanDetector = sensitiveDetector{
+		name:       "IBAN",
+		confidence: confidenceHigh,
+		pattern:    regexp.MustCompile(`\b[A-Z]{2}\d{2}(?: ?[A-Z0-9]){11,30}\b`),
+		validate:   isIBANValid,
+	}
+	phoneDetector = sensitiveDetector{
+		name:       "phone number",
+		confidence: confidenceMedium,
+		pattern:    regexp.MustCompile(`(?:^|[^\w+])(\+[1-9]\d{7,14})\b`),
+	}
+	emailDetector = sensitiveDetector{
+		name:       "email address",
+		confidence: confidenceHigh,
+		pattern: regexp.MustCompile(
+			`\b[A-Za-z0-9._%+-]+@[A-Za-z0-9](?:[A-Za-z0-9-]*[A-Za-z0-9])?(?:\.[A-Za-z0-9](?:[A-Za-z0-9-]*[A-Za-z0-9])?)*\.[A-Za-z]{2,}\b`),
+	}
+	ipv4Detector = sensitiveDetector{
+		name:       "IP address",
+		confidence: confidenceMedium,
+		pattern:    regexp.MustCompile(`\b\d{1,3}(?:\.\d{1,3}){3}\b`),
+		validate:   isPublicIP,
+	}
+	ipv6Detector = sensitiveDetector{
+		name:       "IP address",
+		confidence: confidenceMedium,
+		pattern:    regexp.MustCompile(`(?i)(?:^|[^\w:.])([0-9a-f]{0,4}(?::[0-9a-f]{0,4}){2,7})(?:$|[^\w:])`),
+		validate:   isIPv6Candidate,
+	}
+)
+
+// piiDetectors returns the PII detectors of the enabled categories.
+func piiDetectors(cards, ibans, phones, emails, ips bool) []sensitiveDetector {
+	var detectors []sensitiveDetector
+	if cards {
+		detectors = append(detectors, cardNumberDetector)
+	}
+	if ibans {
+		detectors = append(detectors, ibanDetector)
+	}
+	if phones {
+		detectors = append(detectors, phoneDetector)
+	}
+	if emails {
+		detectors = append(detectors, emailDetector)
+	}
+	if ips {
+		detectors = append(detectors, ipv4Detector, ipv6Detector)
+	}
+	return detectors
+}
+
+// isLuhnValid reports whether the digits of s, ignoring spaces and dashes,
+// form a card number of 13 to 19 digits with a valid Luhn checksum.
+func isLuhnValid(s string) bool {
+	digits := strings.NewReplacer(" ", "", "-", "").Replace(s)
+	if len(digits) < 13 || len(digits) > 19 {
+		return false
+	}
+
+	sum := 0
+	for i := range len(digits) {
+		d := int(digits[len(digits)-1-i] - '0')
+		if i%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return sum%10 == 0
+}
+
+// isIBANValid reports whether s, ignoring spaces, is an IBAN with a valid ISO 7064 mod 97-10 checksum.
+func isIBANValid(s string) bool {
+	iban := strings.ReplaceAll(s, " ", "")
+	if len(iban) < 15 || len(iban) > 34 {
+		return false
+	}
+
+	remainder := 0
+	for _, r := range iban[4:] + iban[:4] {
+		switch {
+		case r >= '0' && r <= '9':
+			remainder = (remainder*10 + int(r-'0')) % 97
+		case r >= 'A' && r <= 'Z':
+			remainder = (remainder*100 + int(r-'A'+10)) % 97
+		default:
+			return false
+		}
+	}
+	return remainder == 1
+}
+
+// isIPv6Candidate reports whether s has at least two non-empty hex groups, unlike "::" in
+// "Cache::get", and is a public IP address.
+func isIPv6Candidate(s string) bool {
+	groups := 0
+	for group := range strings.SplitSeq(s, ":") {
+		if group != "" {
+			groups++
+		}
+	}
+	return groups >= 2 && isPublicIP(s)
+}
+
+// isPublicIP reports whether s is an IP address other than a loopback or unspecified one,
+// which identify no one.
+func isPublicIP(s string) bool {
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return false
+	}
+	return !addr.IsLoopback() && !addr.IsUnspecified()
+}
@@ -0,0 +1,87 @@
+package pkg
+
+import (
+	"slices"
+	"testing"
+)
+
+// TestIsLuhnValid tests the isLuhnValid function
+// to ensure only card numbers with a valid checksum are accepted
+func TestIsLuhnValid(t *testing.T) {
+	tests := []struct {
+		name   string
+		number string
+		want   bool
+	}{
+		{name: "visa", number: "4111111111111111", want: true},
+		{name: "mastercard_spaces", number: "5555 5555 5555 4444", want: true},
+		{name: "amex_dashes", number: "3782-822463-10005", want: true},
+		{name: "bad_checksum", number: "4111111111111112", want: false},
+		{name: "too_short", number: "424242424242", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := isLuhnValid(tt.number); got != tt.want {
+				t.Errorf("expected %v, got %v: %s", tt.want, got, tt.name)
+			}
+		})
+	}
+}
+
+// TestIsIBANValid tests the isIBANValid function
+// to ensure only IBANs with a valid mod 97 checksum are accepted
+func TestIsIBANValid(t *testing.T) {
+	tests := []struct {
+		name string
+		iban string
+		want bool
+	}{
+		{name: "germany", iban: "DE89370400440532013000", want: true},
+		{name: "uk_spaces", iban: "GB82 WEST 1234 5698 7654 32", want: true},
+		{name: "norway_shortest", iban: "NO9386011117947", want: true},
+		{name: "bad_checksum", iban: "DE89370400440532013001", want: false},
+		{name: "lowercase", iban: "de89370400440532013000", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := isIBANValid(tt.iban); got != tt.want {
+				t.Errorf("expected %v, got %v: %s", tt.want, got, tt.name)
+			}
+		})
+	}
+}
+
+// TestIPv6Detector tests the ipv6Detector
+// to ensure scope operators and hex-like words are not taken for addresses
+func TestIPv6Detector(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want []string
+	}{
+		{name: "compressed", s: "peer 2001:db8::1 connected", want: []string{"2001:db8::1"}},
+		{name: "full", s: "peer 2001:0db8:0000:0000:0000:ff00:0042:8329", want: []string{"2001:0db8:0000:0000:0000:ff00:0042:8329"}},
+		{name: "scope_operator", s: "calling Cache::get failed", want: nil},
+		{name: "namespace", s: "std::vector overflow", want: nil},
+		{name: "followed_by_letter", s: "schema 2001:db8::1f0x rejected", want: nil},
+		{name: "loopback", s: "listening on ::1", want: nil},
+		{name: "time", s: "job started at 12:30:45", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var got []string
+			for _, f := range ipv6Detector.find(tt.s) {
+				got = append(got, tt.s[f.start:f.end])
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("expected %v, got %v: %s", tt.want, got, tt.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,228 @@
+package pkg
+
+import (
+	"regexp/syntax"
+	"slices"
+	"unicode/utf8"
+)
+
+// detectorPrefilter selects, in a single pass over a string, the detectors whose pattern may match it:
+// the detectors with required literals none of which occur in the string are skipped.
+type detectorPrefilter struct {
+	literals *ahoCorasick
+	// literalDetectors maps each literal of the automaton to the detectors requiring it.
+	literalDetectors [][]int
+	// unfiltered lists the detectors without required literals, which always run.
+	unfiltered []int
+	count      int
+}
+
+// newDetectorPrefilter builds the prefilter of the detectors.
+func newDetectorPrefilter(detectors []sensitiveDetector) *detectorPrefilter {
+	p := &detectorPrefilter{count: len(detectors)}
+	var literals []string
+	index := make(map[string]int)
+	for i, d := range detectors {
+		required := patternLiterals(d.pattern.String())
+		if required == nil {
+			p.unfiltered = append(p.unfiltered, i)
+			continue
+		}
+		for _, literal := range required {
+			j, ok := index[literal]
+			if !ok {
+				j = len(literals)
+				index[literal] = j
+				literals = append(literals, literal)
+				p.literalDetectors = append(p.literalDetectors, nil)
+			}
+			p.literalDetectors[j] = append(p.literalDetectors[j], i)
+		}
+	}
+	p.literals = newAhoCorasick(literals)
+	return p
+}
+
+// candidates reports, by detector index, the detectors that may match s.
+func (p *detectorPrefilter) candidates(s string) []bool {
+	active := make([]bool, p.count)
+	for _, i := range p.unfiltered {
+		active[i] = true
+	}
+	p.literals.findAll(s, func(m acMatch) {
+		for _, i := range p.literalDetectors[m.pattern] {
+			active[i] = true
+		}
+	})
+	return active
+}
+
+// maxLiterals bounds the number of literals expanded from character classes and alternations.
+const maxLiterals = 64
+
+// patternLiterals returns literals of which every match of the regex contains at least one,
+// compared with ASCII letters lowercased, or nil if no such literals are known. Case-insensitive
+// literals disregard the non-ASCII folds of 'k' and 's' (Kelvin sign, long s).
+func patternLiterals(expr string) []string {
+	re, err := syntax.Parse(expr, syntax.Perl)
+	if err != nil {
+		return nil
+	}
+	literals, _ := requiredLiterals(re)
+	return literals
+}
+
+// requiredLiterals computes the literals of patternLiterals for a parsed regex, and reports whether
+// they are exact, that is the regex matches exactly one of them. Concatenations join the exact
+// literals of consecutive parts, so "gh[op]_" yields "ghp_" and "gho_", and keep the most selective
+// literals found; alternations keep the literals of all their branches.
+func requiredLiterals(re *syntax.Regexp) ([]string, bool) {
+	switch re.Op {
+	case syntax.OpLiteral:
+		literal := string(re.Rune)
+		if re.Flags&syntax.FoldCase != 0 && !isASCII(literal) {
+			return nil, false
+		}
+		return []string{lowerASCIIString(literal)}, true
+	case syntax.OpCharClass:
+		return classLiterals(re.Rune)
+	case syntax.OpCapture:
+		return requiredLiterals(re.Sub[0])
+	case syntax.OpPlus:
+		literals, _ := requiredLiterals(re.Sub[0])
+		return literals, false
+	case syntax.OpRepeat:
+		if re.Min == 0 {
+			return nil, false
+		}
+		literals, _ := requiredLiterals(re.Sub[0])
+		return literals, false
+	case syntax.OpConcat:
+		return concatLiterals(re.Sub)
+	case syntax.OpAlternate:
+		var all []string
+		exact := true
+		for _, sub := range re.Sub {
+			literals, subExact := requiredLiterals(sub)
+			if literals == nil || len(all)+len(literals) > maxLiterals {
+				return nil, false
+			}
+			all = append(all, literals...)
+			exact = exact && subExact
+		}
+		return all, exact
+	default:
+		return nil, false
+	}
+}
+
+// concatLiterals computes the required literals of a concatenation of the parts.
+func concatLiterals(parts []*syntax.Regexp) ([]string, bool) {
+	var best, run []string
+	exact := true
+	consider := func(literals []string) {
+		if literals != nil && moreSelective(literals, best) {
+			best = literals
+		}
+	}
+
+	for _, part := range parts {
+		if part.Op == syntax.OpEmptyMatch || part.Op == syntax.OpBeginText || part.Op == syntax.OpEndText ||
+			part.Op == syntax.OpWordBoundary || part.Op == syntax.OpBeginLine || part.Op == syntax.OpEndLine {
+			continue
+		}
+		literals, partExact := requiredLiterals(part)
+		if !partExact {
+			exact = false
+			consider(run)
+			run = nil
+			consider(literals)
+			continue
+		}
+		if run != nil && len(run)*len(literals) <= maxLiterals {
+			run = crossJoin(run, literals)
+			continue
+		}
+		if run != nil {
+			exact = false
+			consider(run)
+		}
+		run = literals
+	}
+	if exact && run != nil {
+		return run, true
+	}
+	consider(run)
+	return best, false
+}
+
+// crossJoin returns every concatenation of a prefix with a suffix.
+func crossJoin(prefixes, suffixes []string) []string {
+	joined := make([]string, 0, len(prefixes)*len(suffixes))
+	for _, prefix := range prefixes {
+		for _, suffix := range suffixes {
+			joined = append(joined, prefix+suffix)
+		}
+	}
+	return joined
+}
+
+// classLiterals expands a small character class of ASCII characters into its lowercased characters.
+func classLiterals(ranges []rune) ([]string, bool) {
+	var literals []string
+	for i := 0; i+1 < len(ranges); i += 2 {
+		if ranges[i+1] >= utf8.RuneSelf || ranges[i+1]-ranges[i] >= maxLiterals {
+			return nil, false
+		}
+		for r := ranges[i]; r <= ranges[i+1]; r++ {
+			literal := string(lowerASCII(byte(r)))
+			if !slices.Contains(literals, literal) {
+				literals = append(literals, literal)
+			}
+		}
+		if len(literals) > 8 {
+			return nil, false
+		}
+	}
+	return literals, literals != nil
+}
+
+// lowerASCIIString lowercases the ASCII letters of s.
+func lowerASCIIString(s string) string {
+	b := []byte(s)
+	for i := range b {
+		b[i] = lowerASCII(b[i])
+	}
+	return string(b)
+}
+
+// moreSelective reports whether the literals a are less likely to occur than the literals b:
+// their shortest literal is longer, or as long but there are fewer of them.
+func moreSelective(a, b []string) bool {
+	if b == nil {
+		return true
+	}
+	if la, lb := shortestLength(a), shortestLength(b); la != lb {
+		return la > lb
+	}
+	return len(a) < len(b)
+}
+
+// shortestLength returns the length of the shortest string.
+func shortestLength(strs []string) int {
+	shortest := len(strs[0])
+	for _, s := range strs[1:] {
+		shortest = min(shortest, len(s))
+	}
+	return shortest
+}
+
+// isASCII reports whether s consists of ASCII characters only.
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,39 @@
+package pkg
+
+import (
+	"slices"
+	"testing"
+)
+
+// TestPatternLiterals tests the patternLiterals function
+// to ensure every match of a regex contains one of the literals found for it
+func TestPatternLiterals(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+		want    []string
+	}{
+		{name: "prefix", pattern: `ghp_[0-9a-zA-Z]{36}`, want: []string{"ghp_"}},
+		{name: "alternation", pattern: `\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`, want: []string{"akia", "asia"}},
+		{name: "factored_alternation", pattern: `(?:ghp|gho)_[0-9a-zA-Z]{36}`, want: []string{"gho_", "ghp_"}},
+		{name: "small_class", pattern: `xox[abp]-`, want: []string{"xoxa-", "xoxb-", "xoxp-"}},
+		{name: "joined_parts", pattern: `sk_(?:live|test)_[0-9A-Za-z]{24,}`, want: []string{"sk_live_", "sk_test_"}},
+		{name: "case_insensitive", pattern: `(?i)bearer\s*[:=]`, want: []string{"bearer"}},
+		{name: "capture", pattern: `sig=([A-Za-z0-9]{16})`, want: []string{"sig="}},
+		{name: "required_repeat", pattern: `(?:ab){2,}c`, want: []string{"ab"}},
+		{name: "optional", pattern: `(?:ghp_)?[0-9a-f]{40}`, want: nil},
+		{name: "optional_branch", pattern: `token|[0-9]+`, want: nil},
+		{name: "character_classes", pattern: `\b\d(?:[ -]?\d){12,18}\b`, want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := patternLiterals(tt.pattern)
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("expected %q, got %q: %s", tt.want, got, tt.name)
+			}
+		})
+	}
+}
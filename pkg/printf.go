@@ -0,0 +1,259 @@
+package pkg
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// checkPrintf checks if the format string of a printf-style logging call matches its arguments
+// and reports verb and type mismatches, missing or extra arguments and the %w directive.
+func checkPrintf(pass *analysis.Pass, call *ast.CallExpr, fmtIndex int, name string) {
+	if fmtIndex >= len(call.Args) {
+		return
+	}
+
+	formatArg := call.Args[fmtIndex]
+	tv, ok := pass.TypesInfo.Types[formatArg]
+	if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+		return
+	}
+	format := constant.StringVal(tv.Value)
+	args := call.Args[fmtIndex+1:]
+	hasEllipsis := call.Ellipsis.IsValid()
+
+	maxArg := -1
+	explicitIndex := false
+	for _, v := range parseFormatVerbs(format) {
+		if strings.Contains(v.text, "[") {
+			explicitIndex = true
+		}
+		if v.badIndex {
+			pass.Reportf(formatArg.Pos(), "%s format %s has invalid argument index", name, v.text)
+			return
+		}
+		if v.argIndex < 0 {
+			continue
+		}
+		if v.verb == 0 {
+			pass.Reportf(formatArg.Pos(), "%s format %s is missing verb at end of string", name, v.text)
+			return
+		}
+
+		for _, star := range v.starArgs {
+			maxArg = max(maxArg, star)
+			if hasEllipsis || star >= len(args) {
+				continue
+			}
+			if !isIntegerType(pass.TypesInfo.TypeOf(args[star])) {
+				pass.Reportf(args[star].Pos(), "%s format %s uses non-int %s as argument of *", name, v.text, exprText(pass, args[star]))
+			}
+		}
+		maxArg = max(maxArg, v.argIndex)
+
+		if v.verb == 'w' {
+			pass.Reportf(formatArg.Pos(), "%s does not support error-wrapping directive %%w", name)
+			continue
+		}
+		if !strings.ContainsRune(printfVerbs, v.verb) {
+			pass.Reportf(formatArg.Pos(), "%s format %s has unknown verb %c", name, v.text, v.verb)
+			continue
+		}
+
+		if hasEllipsis {
+			continue
+		}
+		if v.argIndex >= len(args) {
+			pass.Reportf(formatArg.Pos(), "%s format %s reads arg #%d, but call has %s", name, v.text, v.argIndex+1, pluralArgs(len(args)))
+			continue
+		}
+
+		arg := args[v.argIndex]
+		argType := pass.TypesInfo.TypeOf(arg)
+		if !matchesVerb(argType, v.verb, make(map[types.Type]bool)) {
+			pass.Reportf(arg.Pos(), "%s format %s has arg %s of wrong type %s", name, v.text, exprText(pass, arg), argType)
+		}
+	}
+
+	if hasEllipsis || explicitIndex {
+		return
+	}
+	if used := maxArg + 1; used < len(args) {
+		pass.Reportf(call.Pos(), "%s call needs %s but has %s", name, pluralArgs(used), pluralArgs(len(args)))
+	}
+}
+
+// pluralArgs formats an argument count, e.g. "1 arg" or "2 args".
+func pluralArgs(n int) string {
+	if n == 1 {
+		return "1 arg"
+	}
+	return fmt.Sprintf("%d args", n)
+}
+
+// matchesVerb reports whether a value of type t can be formatted with the verb,
+// following the rules of the fmt package for basic, composite and interface types.
+func matchesVerb(t types.Type, verb rune, visited map[types.Type]bool) bool {
+	if t == nil || verb == 'v' || verb == 'T' {
+		return true
+	}
+	if visited[t] {
+		return true
+	}
+	visited[t] = true
+
+	if _, ok := t.Underlying().(*types.Interface); ok {
+		return true
+	}
+
+	if strings.ContainsRune("sqxXv", verb) && (isErrorType(t) || isStringerType(t)) {
+		return true
+	}
+
+	switch u := t.Underlying().(type) {
+	case *types.Basic:
+		return matchesBasicVerb(u, verb)
+	case *types.Pointer:
+		if verb == 'p' {
+			return true
+		}
+		if _, ok := u.Elem().Underlying().(*types.Struct); ok {
+			return matchesVerb(u.Elem(), verb, visited)
+		}
+		return strings.ContainsRune("bdoOxX", verb)
+	case *types.Slice:
+		if verb == 'p' {
+			return true
+		}
+		if isByte(u.Elem()) && strings.ContainsRune("sqxX", verb) {
+			return true
+		}
+		return matchesVerb(u.Elem(), verb, visited)
+	case *types.Array:
+		if isByte(u.Elem()) && strings.ContainsRune("sqxX", verb) {
+			return true
+		}
+		return matchesVerb(u.Elem(), verb, visited)
+	case *types.Map:
+		if verb == 'p' {
+			return true
+		}
+		return matchesVerb(u.Key(), verb, visited) && matchesVerb(u.Elem(), verb, visited)
+	case *types.Struct:
+		for i := 0; i < u.NumFields(); i++ {
+			if !matchesVerb(u.Field(i).Type(), verb, visited) {
+				return false
+			}
+		}
+		return true
+	case *types.Chan, *types.Signature:
+		return verb == 'p'
+	default:
+		return false
+	}
+}
+
+// matchesBasicVerb reports whether a value of a basic type can be formatted with the verb.
+func matchesBasicVerb(b *types.Basic, verb rune) bool {
+	info := b.Info()
+	switch {
+	case info&types.IsBoolean != 0:
+		return verb == 't'
+	case info&types.IsInteger != 0:
+		return strings.ContainsRune("bcdoOqxXU", verb)
+	case info&types.IsFloat != 0, info&types.IsComplex != 0:
+		return strings.ContainsRune("beEfFgGxX", verb)
+	case info&types.IsString != 0:
+		return strings.ContainsRune("sqxX", verb)
+	case b.Kind() == types.UnsafePointer:
+		return strings.ContainsRune("pbdoOxX", verb)
+	default:
+		return false
+	}
+}
+
+// isIntegerType reports whether t is an integer type, as required for '*' width and precision.
+func isIntegerType(t types.Type) bool {
+	if t == nil {
+		return true
+	}
+	b, ok := t.Underlying().(*types.Basic)
+	return ok && b.Info()&types.IsInteger != 0
+}
+
+// isByte reports whether t is the byte type.
+func isByte(t types.Type) bool {
+	b, ok := t.Underlying().(*types.Basic)
+	return ok && b.Kind() == types.Byte
+}
+
+// isStringerType reports whether t implements fmt.Stringer.
+func isStringerType(t types.Type) bool {
+	sel := types.NewMethodSet(t).Lookup(nil, "String")
+	if sel == nil {
+		return false
+	}
+	sig, ok := sel.Type().(*types.Signature)
+	return ok && sig.Params().Len() == 0 && sig.Results().Len() == 1 &&
+		types.Identical(sig.Results().At(0).Type(), types.Typ[types.String])
+}
+
+// printfLoggerFormatIndex checks if the called function is declared as a printf logger in the config
+// and returns the index of its format parameter, which precedes the variadic arguments.
+func printfLoggerFormatIndex(pass *analysis.Pass, call *ast.CallExpr, printfLoggers []string) (int, string, bool) {
+	if len(printfLoggers) == 0 {
+		return 0, "", false
+	}
+
+	fn, qualified := calleeFunc(pass, call)
+	if fn == nil {
+		return 0, "", false
+	}
+
+	sig := fn.Type().(*types.Signature)
+	for _, name := range printfLoggers {
+		if name != qualified {
+			continue
+		}
+		params := sig.Params()
+		if !sig.Variadic() || params.Len() < 2 {
+			return 0, "", false
+		}
+		fmtIndex := params.Len() - 2
+		if b, ok := params.At(fmtIndex).Type().Underlying().(*types.Basic); !ok || b.Info()&types.IsString == 0 {
+			return 0, "", false
+		}
+		return fmtIndex, fn.Name(), true
+	}
+	return 0, "", false
+}
+
+// calleeFunc returns the statically called function and its name as "import/path.Func"
+// or "import/path.Type.Method", or nil if the callee is not a known function.
+func calleeFunc(pass *analysis.Pass, call *ast.CallExpr) (*types.Func, string) {
+	fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	if !ok || fn.Pkg() == nil {
+		return nil, ""
+	}
+
+	sig := fn.Type().(*types.Signature)
+	recv := sig.Recv()
+	if recv == nil {
+		return fn, fn.Pkg().Path() + "." + fn.Name()
+	}
+
+	recvType := recv.Type()
+	if ptr, ok := recvType.(*types.Pointer); ok {
+		recvType = ptr.Elem()
+	}
+	named, ok := types.Unalias(recvType).(*types.Named)
+	if !ok {
+		return nil, ""
+	}
+	return fn, fn.Pkg().Path() + "." + named.Obj().Name() + "." + fn.Name()
+}
@@ -0,0 +1,176 @@
+package pkg
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// redactedText replaces the literal secrets removed by redaction fixes.
+const redactedText = "[REDACTED]"
+
+// redactionHelper is a function, such as redact.String, that masks the sensitive value passed to it.
+type redactionHelper struct {
+	pkgPath string
+	name    string
+}
+
+// parseRedactionHelper parses a helper written as "import/path.Func"; an empty string means no helper.
+func parseRedactionHelper(s string) (*redactionHelper, error) {
+	if s == "" {
+		return nil, nil
+	}
+	i := strings.LastIndex(s, ".")
+	if i <= 0 || i == len(s)-1 || strings.Contains(s[i+1:], "/") {
+		return nil, fmt.Errorf("redaction_helper: %q is not of the form import/path.Func", s)
+	}
+	return &redactionHelper{pkgPath: s[:i], name: s[i+1:]}, nil
+}
+
+// qualifiedName returns how the file containing pos refers to the helper, or false if the file
+// does not import its package.
+func (h *redactionHelper) qualifiedName(pass *analysis.Pass, pos token.Pos) (string, bool) {
+	if h.pkgPath == pass.Pkg.Path() {
+		return h.name, true
+	}
+	qualifier := importName(pass, fileOf(pass, pos), h.pkgPath)
+	if qualifier == "" {
+		return "", false
+	}
+	return qualifier + "." + h.name, true
+}
+
+// redacts reports whether the expression is a call of the helper, whose value is already redacted.
+func (h *redactionHelper) redacts(pass *analysis.Pass, expr ast.Expr) bool {
+	if h == nil {
+		return false
+	}
+	call, ok := ast.Unparen(expr).(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+	fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	return ok && fn.Pkg() != nil && fn.Pkg().Path() == h.pkgPath && fn.Name() == h.name
+}
+
+// redactLiteralFix builds a fix replacing the span of a string literal with [REDACTED].
+func redactLiteralFix(lit *ast.BasicLit, start, end int) (analysis.SuggestedFix, bool) {
+	pos, endPos, ok := literalRange(lit, start, end)
+	if !ok {
+		return analysis.SuggestedFix{}, false
+	}
+	return analysis.SuggestedFix{
+		Message:   "Replace the sensitive value with " + redactedText,
+		TextEdits: []analysis.TextEdit{{Pos: pos, End: endPos, NewText: []byte(redactedText)}},
+	}, true
+}
+
+// wrapValueFix builds a fix passing the value through the redaction helper, adding the import of its
+// package when the file does not import it yet, or false if no helper is configured or the import
+// cannot be added.
+func wrapValueFix(pass *analysis.Pass, value ast.Expr, h *redactionHelper) (analysis.SuggestedFix, bool) {
+	if h == nil {
+		return analysis.SuggestedFix{}, false
+	}
+	var edits []analysis.TextEdit
+	name, ok := h.qualifiedName(pass, value.Pos())
+	if !ok {
+		var edit analysis.TextEdit
+		if edit, name, ok = h.importEdit(pass, value.Pos()); !ok {
+			return analysis.SuggestedFix{}, false
+		}
+		edits = append(edits, edit)
+	}
+	return analysis.SuggestedFix{
+		Message: "Redact the value with " + name,
+		TextEdits: append(edits,
+			analysis.TextEdit{Pos: value.Pos(), End: value.Pos(), NewText: []byte(name + "(")},
+			analysis.TextEdit{Pos: value.End(), End: value.End(), NewText: []byte(")")},
+		),
+	}, true
+}
+
+// importEdit builds an edit importing the helper package into the file containing pos and returns
+// how the file will refer to the helper, or false if the package name is unknown or already taken at pos.
+func (h *redactionHelper) importEdit(pass *analysis.Pass, pos token.Pos) (analysis.TextEdit, string, bool) {
+	file := fileOf(pass, pos)
+	pkgName := packageName(pass.Pkg, h.pkgPath)
+	if file == nil || !token.IsIdentifier(pkgName) {
+		return analysis.TextEdit{}, "", false
+	}
+	if scope := pass.Pkg.Scope().Innermost(pos); scope != nil {
+		if _, obj := scope.LookupParent(pkgName, pos); obj != nil {
+			return analysis.TextEdit{}, "", false
+		}
+	}
+
+	spec := strconv.Quote(h.pkgPath)
+	edit := analysis.TextEdit{Pos: file.Name.End(), End: file.Name.End(), NewText: []byte("\n\nimport " + spec)}
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.IMPORT {
+			continue
+		}
+		if gen.Lparen.IsValid() {
+			end := gen.Lparen + 1
+			if len(gen.Specs) > 0 {
+				end = gen.Specs[len(gen.Specs)-1].End()
+			}
+			edit = analysis.TextEdit{Pos: end, End: end, NewText: []byte("\n\t" + spec)}
+		} else {
+			edit = analysis.TextEdit{Pos: gen.End(), End: gen.End(), NewText: []byte("\nimport " + spec)}
+		}
+		break
+	}
+	return edit, pkgName + "." + h.name, true
+}
+
+// packageName returns the name of the package with the given import path if pkg depends on it,
+// or otherwise the last element of the path without a major version suffix such as "/v2".
+func packageName(pkg *types.Package, path string) string {
+	seen := make(map[*types.Package]bool)
+	var find func(*types.Package) string
+	find = func(p *types.Package) string {
+		if seen[p] {
+			return ""
+		}
+		seen[p] = true
+		for _, imported := range p.Imports() {
+			if imported.Path() == path {
+				return imported.Name()
+			}
+			if name := find(imported); name != "" {
+				return name
+			}
+		}
+		return ""
+	}
+	if name := find(pkg); name != "" {
+		return name
+	}
+
+	elems := strings.Split(path, "/")
+	name := elems[len(elems)-1]
+	if len(elems) > 1 && len(name) > 1 && name[0] == 'v' && strings.Trim(name[1:], "0123456789") == "" {
+		name = elems[len(elems)-2]
+	}
+	return name
+}
+
+// removeArgsFix builds a fix removing the call arguments first through last, with the comma before them.
+// The arguments must follow the log message.
+func removeArgsFix(lc *logCall, first, last int) analysis.SuggestedFix {
+	return analysis.SuggestedFix{
+		Message: "Remove the sensitive field",
+		TextEdits: []analysis.TextEdit{{
+			Pos: lc.call.Args[first-1].End(),
+			End: lc.call.Args[last].End(),
+		}},
+	}
+}
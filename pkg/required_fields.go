@@ -0,0 +1,348 @@
+package pkg
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// fieldKeys is the set of field keys a log call carries. Incomplete is set when some of the keys
+// cannot be determined statically, such as for fields passed as fields... or built at runtime.
+type fieldKeys struct {
+	keys       map[string]bool
+	incomplete bool
+}
+
+// checkRequiredFields checks if a log call carries the field keys required by the policies
+// matching its package and level, and reports the missing keys. Keys are taken from the call
+// arguments and from With() chains on the logger, including those assigned to a variable.
+// Calls on loggers the package builds with With() outside the function are skipped.
+func checkRequiredFields(pass *analysis.Pass, lc *logCall, stack []ast.Node, policies []RequiredFieldsPolicy) {
+	required := requiredFieldKeys(pass.Pkg.Path(), lc.level, policies)
+	if len(required) == 0 || lc.call.Ellipsis.IsValid() {
+		return
+	}
+
+	fk := fieldKeys{keys: make(map[string]bool)}
+	if lc.kind == kindKV || lc.kind == kindFields {
+		fk.addArgs(pass, lc.extraArgs())
+	}
+	if lc.lib == libKlog && lc.base == "Error" && lc.kind == kindKV {
+		fk.keys["err"] = true
+	}
+	fk.addLogger(pass, lc.sel.X, outermostFuncBody(stack), make(map[types.Object]bool))
+	if fk.incomplete {
+		return
+	}
+
+	var missing []string
+	for _, key := range required {
+		if !fk.keys[key] {
+			missing = append(missing, key)
+		}
+	}
+	if len(missing) == 0 {
+		return
+	}
+
+	pass.Reportf(lc.call.Pos(), "%s-level log call is missing required fields %s", lc.level, strings.Join(missing, ", "))
+}
+
+// requiredFieldKeys returns the keys required by the policies matching the package and level,
+// without duplicates and in the order they are declared.
+func requiredFieldKeys(pkgPath string, level logLevel, policies []RequiredFieldsPolicy) []string {
+	var keys []string
+	seen := make(map[string]bool)
+	for _, policy := range policies {
+		if level < policy.minLevel {
+			continue
+		}
+		if policy.Packages != "" && !matchesPackagePattern(policy.Packages, pkgPath) {
+			continue
+		}
+		for _, key := range policy.Fields {
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+	return keys
+}
+
+// addArgs adds the keys of typed fields (zap.String("k", v), slog.Int("k", v)) and of
+// key-value pairs ("k", v) found in the arguments.
+func (fk *fieldKeys) addArgs(pass *analysis.Pass, args []ast.Expr) {
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if isFieldValue(pass, arg) {
+			fk.addField(pass, arg)
+			continue
+		}
+		if key, ok := constantString(pass, arg); ok {
+			fk.keys[key] = true
+			i++
+			continue
+		}
+		fk.incomplete = true
+	}
+}
+
+// addField adds the key of a typed field built by a constructor call with a constant key.
+// zap.Error has the implicit "error" key.
+func (fk *fieldKeys) addField(pass *analysis.Pass, expr ast.Expr) {
+	call, ok := ast.Unparen(expr).(*ast.CallExpr)
+	if !ok {
+		fk.incomplete = true
+		return
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		fk.incomplete = true
+		return
+	}
+
+	if sel.Sel.Name == "Error" && selectorPackagePath(pass, sel) == libraryImportPath(libZap) {
+		fk.keys["error"] = true
+		return
+	}
+	if len(call.Args) == 0 {
+		fk.incomplete = true
+		return
+	}
+	key, ok := constantString(pass, call.Args[0])
+	if !ok {
+		fk.incomplete = true
+		return
+	}
+	fk.keys[key] = true
+}
+
+// addLogger adds the keys attached to the logger expression by With, WithField, WithFields and
+// WithError calls, following variables to the values assigned to them in the function body.
+// Loggers it cannot trace, such as parameters, struct fields, package variables and loggers
+// returned by functions outside the logging libraries, are taken to carry no keys, unless the
+// package visibly builds them with With calls, in which case they may carry any keys and make fk incomplete.
+func (fk *fieldKeys) addLogger(pass *analysis.Pass, expr ast.Expr, body *ast.BlockStmt, seen map[types.Object]bool) {
+	switch e := ast.Unparen(expr).(type) {
+	case *ast.CallExpr:
+		sel, ok := e.Fun.(*ast.SelectorExpr)
+		if !ok || !isLoggingLibraryCall(pass, e) {
+			fk.addUntraced(pass, typeutil.Callee(pass.TypesInfo, e))
+			return
+		}
+		switch sel.Sel.Name {
+		case "With":
+			fk.addArgs(pass, e.Args)
+		case "WithField":
+			if len(e.Args) > 0 {
+				if key, ok := constantString(pass, e.Args[0]); ok {
+					fk.keys[key] = true
+				}
+			}
+		case "WithFields":
+			if len(e.Args) > 0 {
+				fk.addCompositeKeys(pass, e.Args[0])
+			}
+		case "WithError":
+			fk.keys["error"] = true
+		}
+		fk.addLogger(pass, sel.X, body, seen)
+	case *ast.Ident:
+		if _, ok := pass.TypesInfo.Uses[e].(*types.PkgName); ok {
+			return
+		}
+		obj, ok := pass.TypesInfo.Uses[e].(*types.Var)
+		if !ok || body == nil || obj.Pos() < body.Pos() || obj.Pos() >= body.End() {
+			fk.addUntraced(pass, pass.TypesInfo.Uses[e])
+			return
+		}
+		if seen[obj] {
+			return
+		}
+		seen[obj] = true
+		values := assignedValues(pass, body, obj)
+		if len(values) == 0 {
+			fk.addUntraced(pass, obj)
+		}
+		for _, value := range values {
+			fk.addLogger(pass, value, body, seen)
+		}
+	case *ast.SelectorExpr:
+		fk.addUntraced(pass, pass.TypesInfo.Uses[e.Sel])
+	}
+}
+
+// addUntraced handles a logger coming from a parameter, field, variable or function that is not
+// followed to its values. It makes fk incomplete if the package builds that logger with With calls
+// anywhere, such as in a constructor setting the field, and otherwise adds no keys.
+func (fk *fieldKeys) addUntraced(pass *analysis.Pass, obj types.Object) {
+	if obj != nil && obj.Pkg() == pass.Pkg && builtWithFields(pass, obj) {
+		fk.incomplete = true
+	}
+}
+
+// builtWithFields reports whether the package assigns a value built by a With call to the variable
+// or field obj, or whether the body of the function obj contains such a call.
+func builtWithFields(pass *analysis.Pass, obj types.Object) bool {
+	found := false
+	for _, file := range pass.Files {
+		ast.Inspect(file, func(n ast.Node) bool {
+			if found {
+				return false
+			}
+			switch node := n.(type) {
+			case *ast.FuncDecl:
+				if pass.TypesInfo.Defs[node.Name] == obj && node.Body != nil {
+					found = containsWithCall(pass, node.Body)
+					return false
+				}
+			case *ast.AssignStmt:
+				if len(node.Lhs) != len(node.Rhs) {
+					return true
+				}
+				for i, lhs := range node.Lhs {
+					if assignedObject(pass, lhs) == obj && containsWithCall(pass, node.Rhs[i]) {
+						found = true
+					}
+				}
+			case *ast.ValueSpec:
+				if len(node.Names) != len(node.Values) {
+					return true
+				}
+				for i, name := range node.Names {
+					if pass.TypesInfo.Defs[name] == obj && containsWithCall(pass, node.Values[i]) {
+						found = true
+					}
+				}
+			case *ast.KeyValueExpr:
+				if key, ok := node.Key.(*ast.Ident); ok && pass.TypesInfo.Uses[key] == obj && containsWithCall(pass, node.Value) {
+					found = true
+				}
+			}
+			return true
+		})
+		if found {
+			return true
+		}
+	}
+	return false
+}
+
+// assignedObject returns the variable or field an assignment target refers to.
+func assignedObject(pass *analysis.Pass, lhs ast.Expr) types.Object {
+	switch e := ast.Unparen(lhs).(type) {
+	case *ast.Ident:
+		return pass.TypesInfo.ObjectOf(e)
+	case *ast.SelectorExpr:
+		return pass.TypesInfo.Uses[e.Sel]
+	default:
+		return nil
+	}
+}
+
+// containsWithCall reports whether the node contains a With, WithField, WithFields or WithError
+// call of a supported logging library.
+func containsWithCall(pass *analysis.Pass, node ast.Node) bool {
+	found := false
+	ast.Inspect(node, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || found {
+			return !found
+		}
+		if sel, ok := call.Fun.(*ast.SelectorExpr); ok && isLoggingLibraryCall(pass, call) {
+			switch sel.Sel.Name {
+			case "With", "WithField", "WithFields", "WithError":
+				found = true
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+// isLoggingLibraryCall reports whether the call is a function or method of a supported logging
+// library, such as zap.L(), slog.Default() or logger.Named("http").
+func isLoggingLibraryCall(pass *analysis.Pass, call *ast.CallExpr) bool {
+	fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	if !ok || fn.Pkg() == nil {
+		return false
+	}
+	path := fn.Pkg().Path()
+	return path == libraryImportPath(libZap) || libraryByPackage(path) != libUnknown
+}
+
+// addCompositeKeys adds the constant keys of a map literal such as logrus.Fields{"k": v}.
+func (fk *fieldKeys) addCompositeKeys(pass *analysis.Pass, expr ast.Expr) {
+	lit, ok := ast.Unparen(expr).(*ast.CompositeLit)
+	if !ok {
+		fk.incomplete = true
+		return
+	}
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		if key, ok := constantString(pass, kv.Key); ok {
+			fk.keys[key] = true
+		}
+	}
+}
+
+// assignedValues returns the expressions assigned to the variable in the function body,
+// both in its declaration and in later assignments.
+func assignedValues(pass *analysis.Pass, body *ast.BlockStmt, obj types.Object) []ast.Expr {
+	var values []ast.Expr
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.AssignStmt:
+			if len(node.Lhs) != len(node.Rhs) {
+				return true
+			}
+			for i, lhs := range node.Lhs {
+				if ident, ok := lhs.(*ast.Ident); ok && pass.TypesInfo.ObjectOf(ident) == obj {
+					values = append(values, node.Rhs[i])
+				}
+			}
+		case *ast.ValueSpec:
+			if len(node.Names) != len(node.Values) {
+				return true
+			}
+			for i, name := range node.Names {
+				if pass.TypesInfo.Defs[name] == obj {
+					values = append(values, node.Values[i])
+				}
+			}
+		}
+		return true
+	})
+	return values
+}
+
+// outermostFuncBody returns the body of the outermost function containing the current node,
+// so variables captured by closures are resolved too, or nil outside of functions.
+func outermostFuncBody(stack []ast.Node) *ast.BlockStmt {
+	for _, node := range stack {
+		switch fn := node.(type) {
+		case *ast.FuncDecl:
+			return fn.Body
+		case *ast.FuncLit:
+			return fn.Body
+		}
+	}
+	return nil
+}
+
+// constantString returns the value of a constant string expression.
+func constantString(pass *analysis.Pass, expr ast.Expr) (string, bool) {
+	tv, ok := pass.TypesInfo.Types[expr]
+	if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+		return "", false
+	}
+	return constant.StringVal(tv.Value), true
+}
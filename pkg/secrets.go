@@ -0,0 +1,75 @@
+package pkg
+
+import (
+	"regexp"
+)
+
+// secretDetectors recognizes common secrets that should not be included in log messages or field values.
+// More specific detectors come first, since findings overlapping an earlier one are dropped. Detectors
+// of prefixed or structured formats have a high confidence; generic shapes, such as UUIDs, which are
+// usually request IDs, have a lower one.
+var secretDetectors = []sensitiveDetector{
+	// Private Keys
+	{name: "Private Key", confidence: confidenceHigh, pattern: regexp.MustCompile(`-----BEGIN (?:RSA |EC |DSA |OPENSSH |PGP )?PRIVATE KEY(?: BLOCK)?-----`)},
+
+	// JWT Tokens
+	{name: "JWT token", confidence: confidenceHigh, pattern: regexp.MustCompile(`eyJ[A-Za-z0-9_-]{10,}\.[A-Za-z0-9_-]{10,}\.[A-Za-z0-9_-]{10,}`)},
+
+	// GitHub
+	{name: "GitHub Personal Access Token", confidence: confidenceHigh, pattern: regexp.MustCompile(`ghp_[0-9a-zA-Z]{36}`)},
+	{name: "GitHub Fine-Grained Personal Access Token", confidence: confidenceHigh, pattern: regexp.MustCompile(`github_pat_[0-9a-zA-Z_]{82}`)},
+	{name: "GitHub OAuth Access Token", confidence: confidenceHigh, pattern: regexp.MustCompile(`gho_[0-9a-zA-Z]{36}`)},
+	{name: "GitHub App Token", confidence: confidenceHigh, pattern: regexp.MustCompile(`(?:ghu|ghs)_[0-9a-zA-Z]{36}`)},
+	{name: "GitHub Refresh Token", confidence: confidenceHigh, pattern: regexp.MustCompile(`ghr_[0-9a-zA-Z]{36}`)},
+
+	// GitLab
+	{name: "GitLab Personal Access Token", confidence: confidenceHigh, pattern: regexp.MustCompile(`glpat-[0-9a-zA-Z_-]{20}`)},
+
+	// AWS
+	{name: "AWS Access Key ID", confidence: confidenceHigh, pattern: regexp.MustCompile(`\b(?:AKIA|ASIA|ABIA|ACCA)[0-9A-Z]{16}\b`)},
+	{
+		name:       "AWS Secret Access Key",
+		confidence: confidenceHigh,
+		pattern:    regexp.MustCompile(`(?i)aws_?secret_?access_?key['"]?\s*[:=]\s*['"]?([A-Za-z0-9/+=]{40})\b`),
+	},
+
+	// GCP
+	{name: "GCP service account key", confidence: confidenceHigh, pattern: regexp.MustCompile(`"type"\s*:\s*"service_account"`)},
+	{name: "GCP service account key", confidence: confidenceHigh, pattern: regexp.MustCompile(`"private_key_id"\s*:\s*"[0-9a-f]{40}"`)},
+	{name: "Google API Key", confidence: confidenceHigh, pattern: regexp.MustCompile(`\bAIza[0-9A-Za-z_-]{35}\b`)},
+
+	// Azure
+	{
+		name:       "Azure Storage connection string",
+		confidence: confidenceHigh,
+		pattern:    regexp.MustCompile(`DefaultEndpointsProtocol=https?;AccountName=[^;\s]+;AccountKey=[A-Za-z0-9+/=]{40,}`),
+	},
+	{
+		name:       "Azure Service Bus connection string",
+		confidence: confidenceHigh,
+		pattern:    regexp.MustCompile(`Endpoint=sb://[^;\s]+;SharedAccessKeyName=[^;\s]+;SharedAccessKey=[A-Za-z0-9+/=]{40,}`),
+	},
+
+	// Slack
+	{name: "Slack token", confidence: confidenceHigh, pattern: regexp.MustCompile(`\bxox[abposr]-[0-9A-Za-z-]{10,}`)},
+	{
+		name:       "Slack webhook URL",
+		confidence: confidenceHigh,
+		pattern:    regexp.MustCompile(`https://hooks\.slack\.com/services/T[0-9A-Z]{8,}/B[0-9A-Z]{8,}/[0-9A-Za-z]{24}`),
+	},
+
+	// Stripe
+	{name: "Stripe secret key", confidence: confidenceHigh, pattern: regexp.MustCompile(`\b(?:sk|rk)_(?:live|test)_[0-9A-Za-z]{24,}`)},
+
+	// npm
+	{name: "npm access token", confidence: confidenceHigh, pattern: regexp.MustCompile(`\bnpm_[0-9A-Za-z]{36}\b`)},
+
+	// Twilio
+	{name: "Twilio API key", confidence: confidenceMedium, pattern: regexp.MustCompile(`\bSK[0-9a-f]{32}\b`)},
+
+	// Tokens
+	{name: "Bearer/Auth Token", confidence: confidenceMedium, pattern: regexp.MustCompile(`(?i)(?:bearer|token)['"]?\s*[:=]\s*['"]?[0-9a-zA-Z\-_.]{20,}`)},
+
+	// Generic patterns
+	{name: "UUID", confidence: confidenceLow, pattern: regexp.MustCompile(`[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}`)},
+}
@@ -0,0 +1,356 @@
+package pkg
+
+import (
+	"fmt"
+	"go/ast"
+	"regexp"
+	"slices"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// confidence is how likely a detector finding is an actual sensitive value.
+type confidence int
+
+const (
+	confidenceLow confidence = iota
+	confidenceMedium
+	confidenceHigh
+)
+
+// confidenceNames maps the confidence names used in configs and diagnostics to the confidences.
+var confidenceNames = map[string]confidence{
+	"low":    confidenceLow,
+	"medium": confidenceMedium,
+	"high":   confidenceHigh,
+}
+
+// parseConfidence parses a confidence name case-insensitively.
+func parseConfidence(name string) (confidence, bool) {
+	c, ok := confidenceNames[strings.ToLower(name)]
+	return c, ok
+}
+
+// String returns the name of the confidence.
+func (c confidence) String() string {
+	switch c {
+	case confidenceLow:
+		return "low"
+	case confidenceMedium:
+		return "medium"
+	default:
+		return "high"
+	}
+}
+
+// sensitiveDetector recognizes a kind of sensitive value, such as an email address, in a string.
+type sensitiveDetector struct {
+	// name describes the detected value in diagnostics, e.g. "email address".
+	name string
+	// confidence is how likely a finding of the detector is an actual sensitive value.
+	confidence confidence
+	// pattern finds the candidates; if it has a capturing group, the group is the candidate.
+	pattern *regexp.Regexp
+	// validate, if set, filters the candidates, e.g. by checksum.
+	validate func(string) bool
+}
+
+// sensitiveFinding is a span of a string recognized by a detector.
+type sensitiveFinding struct {
+	detector   *sensitiveDetector
+	start, end int
+}
+
+// find returns the validated candidates of the detector in s.
+func (d *sensitiveDetector) find(s string) []sensitiveFinding {
+	var findings []sensitiveFinding
+	for _, loc := range d.pattern.FindAllStringSubmatchIndex(s, -1) {
+		start, end := loc[0], loc[1]
+		if len(loc) >= 4 && loc[2] >= 0 {
+			start, end = loc[2], loc[3]
+		}
+		if d.validate != nil && !d.validate(s[start:end]) {
+			continue
+		}
+		findings = append(findings, sensitiveFinding{detector: d, start: start, end: end})
+	}
+	return findings
+}
+
+// findSensitive runs the detectors in order over s and returns their findings,
+// dropping those overlapping a finding of an earlier detector. If active is not nil,
+// only the detectors it marks run.
+func findSensitive(detectors []sensitiveDetector, s string, active []bool) []sensitiveFinding {
+	var findings []sensitiveFinding
+	var reported [][]int
+	for i := range detectors {
+		if active != nil && !active[i] {
+			continue
+		}
+		for _, f := range detectors[i].find(s) {
+			loc := []int{f.start, f.end}
+			if overlapsAny(loc, reported) {
+				continue
+			}
+			reported = append(reported, loc)
+			findings = append(findings, f)
+		}
+	}
+	return findings
+}
+
+// compileSensitiveKeywords applies the additions and removals of the config to the built-in
+// sensitive keywords. Keywords are case-insensitive, and removing an unknown keyword is an error.
+func compileSensitiveKeywords(cfg SensitiveKeywords) ([]string, error) {
+	keywords := slices.Clone(sensitiveKeywords)
+	for _, keyword := range cfg.Remove {
+		i := slices.Index(keywords, strings.ToLower(strings.TrimSpace(keyword)))
+		if i < 0 {
+			return nil, fmt.Errorf("sensitive_keywords.remove: unknown keyword %q", keyword)
+		}
+		keywords = slices.Delete(keywords, i, i+1)
+	}
+	for _, keyword := range cfg.Add {
+		normalized := strings.ToLower(strings.TrimSpace(keyword))
+		if len(keywordSegments(normalized)) == 0 {
+			return nil, fmt.Errorf("sensitive_keywords.add: keyword %q has no letters or digits", keyword)
+		}
+		if !slices.Contains(keywords, normalized) {
+			keywords = append(keywords, normalized)
+		}
+	}
+	return keywords, nil
+}
+
+// compileSensitivePatterns compiles the named regexes of the config into detectors,
+// of high confidence unless the pattern sets another one.
+func compileSensitivePatterns(patterns []SensitivePattern) ([]sensitiveDetector, error) {
+	detectors := make([]sensitiveDetector, 0, len(patterns))
+	for i, p := range patterns {
+		if strings.TrimSpace(p.Name) == "" {
+			return nil, fmt.Errorf("sensitive_patterns[%d]: name is required", i)
+		}
+		conf := confidenceHigh
+		if p.Confidence != "" {
+			var ok bool
+			if conf, ok = parseConfidence(p.Confidence); !ok {
+				return nil, fmt.Errorf("sensitive_patterns[%d] %q: unknown confidence %q", i, p.Name, p.Confidence)
+			}
+		}
+		re, err := regexp.Compile(p.Regex)
+		if err != nil {
+			return nil, fmt.Errorf("sensitive_patterns[%d] %q: %w", i, p.Name, err)
+		}
+		if re.MatchString("") {
+			return nil, fmt.Errorf("sensitive_patterns[%d] %q: regex matches the empty string", i, p.Name)
+		}
+		detectors = append(detectors, sensitiveDetector{name: p.Name, confidence: conf, pattern: re})
+	}
+	return detectors, nil
+}
+
+// sensitiveMatcher finds sensitive keywords and values in strings, ignoring those inside allowlisted phrases.
+// It is built once per config, so that each string is scanned once for all keywords, once for the literals
+// the detector regexes require and once for the allowlisted phrases, and only candidate regexes run.
+type sensitiveMatcher struct {
+	keywords        []string
+	keywordSegments [][]string
+	// keywordPrefilter matches the first segments of the keywords in a string, which contains them
+	// whenever a keyword matches, so that most strings are rejected without being split into segments.
+	keywordPrefilter *ahoCorasick
+	// keywordAutomaton matches the keyword segments in the segment text of a string.
+	keywordAutomaton *ahoCorasick
+	detectors        []sensitiveDetector
+	prefilter        *detectorPrefilter
+	// allowlist matches the allowlisted phrases; it is nil without phrases.
+	allowlist *ahoCorasick
+	// minConfidence is the confidence below which findings are not reported.
+	minConfidence confidence
+}
+
+// newSensitiveMatcher builds a matcher of the keywords and detectors; the allowlist phrases
+// are matched ignoring the case of ASCII letters.
+func newSensitiveMatcher(keywords []string, detectors []sensitiveDetector, allowlist []string) *sensitiveMatcher {
+	m := &sensitiveMatcher{keywords: keywords, detectors: detectors, prefilter: newDetectorPrefilter(detectors)}
+	patterns := make([]string, 0, len(keywords))
+	var firstSegments []string
+	for _, keyword := range keywords {
+		segments := keywordSegments(keyword)
+		m.keywordSegments = append(m.keywordSegments, segments)
+		patterns = append(patterns, keywordPattern(segments))
+		if !slices.Contains(firstSegments, segments[0]) {
+			firstSegments = append(firstSegments, segments[0])
+		}
+	}
+	m.keywordPrefilter = newAhoCorasick(firstSegments)
+	m.keywordAutomaton = newAhoCorasick(patterns)
+	if len(allowlist) > 0 {
+		m.allowlist = newAhoCorasick(allowlist)
+	}
+	return m
+}
+
+// report reports a sensitive-data finding unless its confidence is below the minimum one,
+// with the confidence as the diagnostic category and at the end of the message.
+func (m *sensitiveMatcher) report(pass *analysis.Pass, diag analysis.Diagnostic, conf confidence) {
+	if conf < m.minConfidence {
+		return
+	}
+	diag.Category = "sensitive-data/" + conf.String()
+	diag.Message += " (" + conf.String() + " confidence)"
+	pass.Report(diag)
+}
+
+// findKeyword returns the first match of the first keyword, in list order, found in s
+// outside allowlisted phrases.
+func (m *sensitiveMatcher) findKeyword(s string) (keywordMatch, bool) {
+	matches := m.findKeywords(s)
+	if len(matches) == 0 {
+		return keywordMatch{}, false
+	}
+	return matches[0], true
+}
+
+// findKeywords returns the matches of the keywords, in list order, found in s outside allowlisted phrases.
+func (m *sensitiveMatcher) findKeywords(s string) []keywordMatch {
+	if !m.keywordPrefilter.contains(s) {
+		return nil
+	}
+	segments := identifierSegments(s)
+	if len(segments) == 0 {
+		return nil
+	}
+	text, offsets := segmentText(segments)
+
+	type indexedMatch struct {
+		keywordMatch
+		index int
+	}
+	var found []indexedMatch
+	m.keywordAutomaton.findAll(text, func(am acMatch) {
+		first, _ := slices.BinarySearch(offsets, am.start+1)
+		last := first + len(m.keywordSegments[am.pattern]) - 1
+		found = append(found, indexedMatch{
+			keywordMatch: keywordMatch{keyword: m.keywords[am.pattern], start: segments[first].start, end: segments[last].end},
+			index:        am.pattern,
+		})
+	})
+	if len(found) == 0 {
+		return nil
+	}
+	slices.SortFunc(found, func(a, b indexedMatch) int {
+		if a.index != b.index {
+			return a.index - b.index
+		}
+		return a.start - b.start
+	})
+
+	allowed := m.allowedRanges(s)
+	matches := make([]keywordMatch, 0, len(found))
+	for _, match := range found {
+		if !containedInAny(match.start, match.end, allowed) {
+			matches = append(matches, match.keywordMatch)
+		}
+	}
+	return matches
+}
+
+// findValues returns the findings of the detectors in s outside allowlisted phrases.
+// Only the detectors selected by the prefilter run.
+func (m *sensitiveMatcher) findValues(s string) []sensitiveFinding {
+	found := findSensitive(m.detectors, s, m.prefilter.candidates(s))
+	if len(found) == 0 {
+		return nil
+	}
+
+	allowed := m.allowedRanges(s)
+	var findings []sensitiveFinding
+	for _, f := range found {
+		if !containedInAny(f.start, f.end, allowed) {
+			findings = append(findings, f)
+		}
+	}
+	return findings
+}
+
+// allowedRanges returns the byte ranges of the allowlisted phrases in s.
+func (m *sensitiveMatcher) allowedRanges(s string) [][]int {
+	if m.allowlist == nil {
+		return nil
+	}
+	var ranges [][]int
+	m.allowlist.findAll(s, func(am acMatch) {
+		ranges = append(ranges, []int{am.start, am.end})
+	})
+	return ranges
+}
+
+// containedInAny reports whether the range [start, end) lies within one of the ranges.
+func containedInAny(start, end int, ranges [][]int) bool {
+	for _, r := range ranges {
+		if r[0] <= start && end <= r[1] {
+			return true
+		}
+	}
+	return false
+}
+
+// checkSensitiveValues checks the log message and the constant strings passed as arguments,
+// including field values such as zap.String("email", "john@example.com"), with the detectors
+// and reports every finding with the confidence of its detector, which is also the diagnostic category.
+// Findings in string literals come with a fix replacing them with [REDACTED].
+func checkSensitiveValues(pass *analysis.Pass, lc *logCall, m *sensitiveMatcher) {
+	if len(m.detectors) == 0 {
+		return
+	}
+
+	for i, expr := range constantStringArgs(pass, lc) {
+		what := "log argument"
+		if i == 0 && expr == lc.message() {
+			what = "log message"
+		}
+		value, _ := constantString(pass, expr)
+		for _, f := range m.findValues(value) {
+			diag := analysis.Diagnostic{
+				Pos:     expr.Pos(),
+				End:     expr.End(),
+				Message: fmt.Sprintf("%s contains %s", what, f.detector.name),
+			}
+			if lit, ok := expr.(*ast.BasicLit); ok {
+				if p, e, ok := literalRange(lit, f.start, f.end); ok {
+					diag.Pos, diag.End = p, e
+				}
+				if fix, ok := redactLiteralFix(lit, f.start, f.end); ok {
+					diag.SuggestedFixes = []analysis.SuggestedFix{fix}
+				}
+			}
+			m.report(pass, diag, f.detector.confidence)
+		}
+	}
+}
+
+// constantStringArgs returns the constant string expressions among the log message, the arguments
+// following it and the arguments of field constructors such as zap.String or slog.String.
+func constantStringArgs(pass *analysis.Pass, lc *logCall) []ast.Expr {
+	var exprs []ast.Expr
+	add := func(expr ast.Expr) {
+		if _, ok := constantString(pass, expr); ok {
+			exprs = append(exprs, expr)
+		}
+	}
+
+	if msg := lc.message(); msg != nil {
+		add(msg)
+	}
+	for _, arg := range lc.extraArgs() {
+		if call, ok := ast.Unparen(arg).(*ast.CallExpr); ok && isFieldValue(pass, arg) {
+			for _, fieldArg := range call.Args {
+				add(fieldArg)
+			}
+			continue
+		}
+		add(arg)
+	}
+	return exprs
+}
@@ -0,0 +1,133 @@
+package pkg
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// checkSensitivePrintfArgs checks the arguments of a printf-style call, whose format is the argument
+// at fmtIndex, for variables, fields and elements whose name ends with a sensitive keyword, such as
+// u.Password or cfg.APIKey, and reports the argument with medium confidence, whatever its type.
+// Arguments the format only prints with verbs other than the value verbs %v, %s, %q, %x and %X, such as
+// %d, %t or %T, are skipped, and so are, when skipAttached is set, those reported with the keyword of
+// the format they are attached to. With a redaction helper, a fix passes string and byte slice arguments through it.
+func checkSensitivePrintfArgs(pass *analysis.Pass, call *ast.CallExpr, fmtIndex int, m *sensitiveMatcher, helper *redactionHelper, skipAttached bool) {
+	if fmtIndex >= len(call.Args) || call.Ellipsis.IsValid() {
+		return
+	}
+
+	var attached map[ast.Expr]bool
+	if skipAttached {
+		attached = attachedArgs(pass, call, fmtIndex, m)
+	}
+	nonValue := nonValueArgs(pass, call, fmtIndex)
+
+	for i, arg := range call.Args[fmtIndex+1:] {
+		if attached[arg] || nonValue[i] || pass.TypesInfo.Types[arg].Value != nil || helper.redacts(pass, arg) {
+			continue
+		}
+		name := argName(arg)
+		if name == "" {
+			continue
+		}
+		match, ok := trailingKeyword(m, name)
+		if !ok && strings.HasSuffix(name, "s") {
+			// Slices and maps of secrets are usually named in the plural, such as tokens.
+			match, ok = trailingKeyword(m, strings.TrimSuffix(name, "s"))
+		}
+		if !ok {
+			continue
+		}
+
+		diag := analysis.Diagnostic{
+			Pos:     arg.Pos(),
+			End:     arg.End(),
+			Message: fmt.Sprintf("log argument %s is named after sensitive keyword %q", exprText(pass, arg), match.keyword),
+		}
+		if isStringLike(pass.TypesInfo.TypeOf(arg)) {
+			if fix, ok := wrapValueFix(pass, arg, helper); ok {
+				diag.SuggestedFixes = []analysis.SuggestedFix{fix}
+			}
+		}
+		m.report(pass, diag, confidenceMedium)
+	}
+}
+
+// trailingKeyword returns the match of the first keyword, in list order, that ends the name, such as
+// "password" in userPassword or "api_key" in APIKey. Names where the keyword only qualifies another word,
+// such as TokenExpiry or PasswordRules, hold no secret and do not match.
+func trailingKeyword(m *sensitiveMatcher, name string) (keywordMatch, bool) {
+	for _, match := range m.findKeywords(name) {
+		if match.end == len(name) {
+			return match, true
+		}
+	}
+	return keywordMatch{}, false
+}
+
+// valuePrintfVerbs lists the verbs that print the content of their argument.
+const valuePrintfVerbs = "vsqxX"
+
+// nonValueArgs reports, by index among the arguments following a constant format, the arguments that
+// are only formatted with verbs other than the value verbs, such as %d, %t, %T or %p. Arguments the
+// format does not consume are printed as extra values and are not included.
+func nonValueArgs(pass *analysis.Pass, call *ast.CallExpr, fmtIndex int) map[int]bool {
+	format, ok := constantString(pass, call.Args[fmtIndex])
+	if !ok {
+		return nil
+	}
+	nonValue := make(map[int]bool)
+	valueFormatted := make(map[int]bool)
+	for _, v := range valueVerbs(format) {
+		if strings.ContainsRune(valuePrintfVerbs, v.verb) {
+			valueFormatted[v.argIndex] = true
+		} else {
+			nonValue[v.argIndex] = true
+		}
+	}
+	for i := range valueFormatted {
+		delete(nonValue, i)
+	}
+	return nonValue
+}
+
+// argName returns the name of the variable, field or container the argument reads,
+// e.g. "Password" for u.Password or "tokens" for tokens[i], or an empty string.
+func argName(expr ast.Expr) string {
+	switch e := ast.Unparen(expr).(type) {
+	case *ast.Ident:
+		return e.Name
+	case *ast.SelectorExpr:
+		return e.Sel.Name
+	case *ast.StarExpr:
+		return argName(e.X)
+	case *ast.IndexExpr:
+		return argName(e.X)
+	default:
+		return ""
+	}
+}
+
+// isStringLike reports whether t, or the type it points to, is a string or byte slice type,
+// which the redaction helper is expected to take.
+func isStringLike(t types.Type) bool {
+	if t == nil {
+		return false
+	}
+	if ptr, ok := t.Underlying().(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	switch u := t.Underlying().(type) {
+	case *types.Basic:
+		return u.Info()&types.IsString != 0
+	case *types.Slice:
+		elem, ok := u.Elem().Underlying().(*types.Basic)
+		return ok && elem.Kind() == types.Byte
+	default:
+		return false
+	}
+}
@@ -0,0 +1,242 @@
+package pkg
+
+import (
+	"bufio"
+	_ "embed"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"unicode"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// englishWordList is the embedded English word list, one word per line, most common words first.
+//
+//go:embed wordlists/en.txt
+var englishWordList string
+
+// englishDictionary returns the embedded English dictionary, parsed once.
+var englishDictionary = sync.OnceValue(func() *wordList {
+	return parseWordList(englishWordList)
+})
+
+// wordList is a set of known words that keeps their order, so suggestions prefer earlier, more common words.
+type wordList struct {
+	words []string
+	known map[string]bool
+}
+
+// parseWordList parses a word list with one word per line, ignoring blank lines and '#' comments.
+func parseWordList(text string) *wordList {
+	wl := &wordList{known: make(map[string]bool)}
+	scanner := bufio.NewScanner(strings.NewReader(text))
+	for scanner.Scan() {
+		wl.add(scanner.Text())
+	}
+	return wl
+}
+
+// add adds a word to the list, unless it is blank, a comment or already known.
+func (wl *wordList) add(word string) {
+	word = strings.ToLower(strings.TrimSpace(word))
+	if word == "" || strings.HasPrefix(word, "#") || wl.known[word] {
+		return
+	}
+	wl.known[word] = true
+	wl.words = append(wl.words, word)
+}
+
+// loadWordLists reads the team word lists and the inline words into a single word list.
+// Relative paths are resolved against the directory of the config file.
+func loadWordLists(paths, words []string, configDir string) (*wordList, error) {
+	wl := &wordList{known: make(map[string]bool)}
+	for _, path := range paths {
+		if !filepath.IsAbs(path) && configDir != "" {
+			path = filepath.Join(configDir, path)
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("spell_check_word_lists: %w", err)
+		}
+		for _, word := range parseWordList(string(data)).words {
+			wl.add(word)
+		}
+	}
+	for _, word := range words {
+		wl.add(word)
+	}
+	return wl, nil
+}
+
+// spellChecker checks words against the embedded English dictionary and the team word list.
+type spellChecker struct {
+	english *wordList
+	team    *wordList
+}
+
+// isKnown reports whether the lowercase word is in one of the dictionaries.
+func (sc *spellChecker) isKnown(word string) bool {
+	return sc.english.known[word] || sc.team.known[word]
+}
+
+// suggest returns the closest known word by edit distance, preferring team words and then
+// more common words on ties, or false if no word is close enough.
+func (sc *spellChecker) suggest(word string) (string, bool) {
+	maxDistance := 1
+	if len(word) > 4 {
+		maxDistance = 2
+	}
+
+	best, bestDistance := "", maxDistance+1
+	for _, wl := range []*wordList{sc.team, sc.english} {
+		for _, candidate := range wl.words {
+			if abs(len(candidate)-len(word)) >= bestDistance {
+				continue
+			}
+			if d := osaDistance(word, candidate); d < bestDistance {
+				best, bestDistance = candidate, d
+			}
+		}
+	}
+	return best, best != ""
+}
+
+// messageWord is a word of a log message with its byte offsets in the message.
+type messageWord struct {
+	text       string
+	start, end int
+}
+
+var (
+	// messageChunk matches the whitespace-separated parts of a log message.
+	messageChunk = regexp.MustCompile(`\S+`)
+	// hexString matches hexadecimal strings such as hashes and IDs.
+	hexString = regexp.MustCompile(`^(0[xX])?[0-9a-fA-F]{8,}$`)
+)
+
+// messageWords returns the words of a log message worth spell-checking. Parts with format verbs,
+// digits, identifiers (snake_case, camelCase, ACRONYMS), paths, URLs, hex strings and
+// contractions are skipped, and hyphenated words are checked part by part.
+func messageWords(msg string) []messageWord {
+	var words []messageWord
+	for _, loc := range messageChunk.FindAllStringIndex(msg, -1) {
+		chunk := msg[loc[0]:loc[1]]
+		if len(valueVerbs(chunk)) > 0 {
+			continue
+		}
+
+		trimmed := strings.TrimLeftFunc(chunk, isWordPunct)
+		start := loc[0] + len(chunk) - len(trimmed)
+		trimmed = strings.TrimRightFunc(trimmed, isWordPunct)
+		if trimmed == "" || hexString.MatchString(trimmed) || strings.ContainsAny(trimmed, "_/.:@=\\'%") {
+			continue
+		}
+
+		offset := start
+		for part := range strings.SplitSeq(trimmed, "-") {
+			if isPlainWord(part) {
+				words = append(words, messageWord{text: part, start: offset, end: offset + len(part)})
+			}
+			offset += len(part) + 1
+		}
+	}
+	return words
+}
+
+// isWordPunct reports whether the rune is punctuation that may surround a word in a message.
+func isWordPunct(r rune) bool {
+	return strings.ContainsRune(`.,:;!?()[]{}<>"'`+"`", r)
+}
+
+// isPlainWord reports whether s is an ASCII word of at least three letters, all lowercase
+// or capitalized, so identifiers and acronyms are not spell-checked.
+func isPlainWord(s string) bool {
+	if len(s) < 3 {
+		return false
+	}
+	for i, r := range s {
+		if r > unicode.MaxASCII || !unicode.IsLetter(r) {
+			return false
+		}
+		if i > 0 && unicode.IsUpper(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// checkSpelling checks the words of the log message against the dictionaries and reports
+// misspelled words that have a close suggestion, with a fix replacing them.
+func checkSpelling(pass *analysis.Pass, lc *logCall, sc *spellChecker) {
+	lit, msg, ok := messageLiteral(lc)
+	if !ok {
+		return
+	}
+
+	for _, word := range messageWords(msg) {
+		lower := strings.ToLower(word.text)
+		if sc.isKnown(lower) {
+			continue
+		}
+		suggestion, ok := sc.suggest(lower)
+		if !ok {
+			continue
+		}
+		if unicode.IsUpper(rune(word.text[0])) {
+			suggestion = strings.ToUpper(suggestion[:1]) + suggestion[1:]
+		}
+
+		diag := analysis.Diagnostic{
+			Pos:     lit.Pos(),
+			End:     lit.End(),
+			Message: fmt.Sprintf("log message contains misspelled word %q; did you mean %q?", word.text, suggestion),
+		}
+		if pos, end, ok := literalRange(lit, word.start, word.end); ok {
+			diag.Pos, diag.End = pos, end
+			diag.SuggestedFixes = []analysis.SuggestedFix{{
+				Message:   fmt.Sprintf("Replace %q with %q", word.text, suggestion),
+				TextEdits: []analysis.TextEdit{{Pos: pos, End: end, NewText: []byte(suggestion)}},
+			}}
+		}
+		pass.Report(diag)
+	}
+}
+
+// osaDistance returns the optimal string alignment distance between a and b: the Levenshtein
+// distance where swapping two adjacent characters also counts as a single edit.
+func osaDistance(a, b string) int {
+	rows := make([][]int, len(a)+1)
+	for i := range rows {
+		rows[i] = make([]int, len(b)+1)
+		rows[i][0] = i
+	}
+	for j := range rows[0] {
+		rows[0][j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			rows[i][j] = min(rows[i-1][j]+1, rows[i][j-1]+1, rows[i-1][j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				rows[i][j] = min(rows[i][j], rows[i-2][j-2]+1)
+			}
+		}
+	}
+	return rows[len(a)][len(b)]
+}
+
+// abs returns the absolute value of x.
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
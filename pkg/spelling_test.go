@@ -0,0 +1,150 @@
+package pkg
+
+import (
+	"slices"
+	"testing"
+)
+
+// TestOSADistance tests the osaDistance function
+// to ensure adjacent transpositions count as a single edit
+func TestOSADistance(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want int
+	}{
+		{
+			name: "equal",
+			a:    "received",
+			b:    "received",
+			want: 0,
+		},
+		{
+			name: "transposition",
+			a:    "recieved",
+			b:    "received",
+			want: 1,
+		},
+		{
+			name: "deletion",
+			a:    "conection",
+			b:    "connection",
+			want: 1,
+		},
+		{
+			name: "substitution_and_insertion",
+			a:    "wirt",
+			b:    "write",
+			want: 2,
+		},
+		{
+			name: "empty",
+			a:    "",
+			b:    "log",
+			want: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := osaDistance(tt.a, tt.b)
+
+			if got != tt.want {
+				t.Errorf("expected %d, got %d: %s", tt.want, got, tt.name)
+			}
+		})
+	}
+}
+
+// TestMessageWords tests the messageWords function
+// to ensure identifiers, format verbs and hex strings are skipped
+func TestMessageWords(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  string
+		want []string
+	}{
+		{
+			name: "plain_words",
+			msg:  "Conection refused, retrying",
+			want: []string{"Conection", "refused", "retrying"},
+		},
+		{
+			name: "format_verbs",
+			msg:  "user %s logged in after %d attempts",
+			want: []string{"user", "logged", "after", "attempts"},
+		},
+		{
+			name: "identifiers",
+			msg:  "userID not set in request_ctx for HTTPServer",
+			want: []string{"not", "set", "for"},
+		},
+		{
+			name: "hex_and_paths",
+			msg:  "digest deadbeef01 of /var/lib/data.db",
+			want: []string{"digest"},
+		},
+		{
+			name: "hyphenated",
+			msg:  "non-blocking write",
+			want: []string{"non", "blocking", "write"},
+		},
+		{
+			name: "contractions",
+			msg:  "couldn't start",
+			want: []string{"start"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var got []string
+			for _, word := range messageWords(tt.msg) {
+				if tt.msg[word.start:word.end] != word.text {
+					t.Errorf("word %q has offsets of %q", word.text, tt.msg[word.start:word.end])
+				}
+				got = append(got, word.text)
+			}
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("expected %v, got %v: %s", tt.want, got, tt.name)
+			}
+		})
+	}
+}
+
+// TestEnglishDictionaryMisspellings tests the embedded English word list
+// to ensure common misspellings are reported with their correction
+func TestEnglishDictionaryMisspellings(t *testing.T) {
+	sc := &spellChecker{english: englishDictionary(), team: parseWordList("")}
+	tests := []struct {
+		word string
+		want string
+	}{
+		{word: "seperate", want: "separate"},
+		{word: "occurence", want: "occurrence"},
+		{word: "existance", want: "existence"},
+		{word: "existant", want: "existent"},
+		{word: "accomodate", want: "accommodate"},
+		{word: "commited", want: "committed"},
+		{word: "dependancy", want: "dependency"},
+		{word: "persistant", want: "persistent"},
+		{word: "maintainance", want: "maintenance"},
+		{word: "unneccesary", want: "unnecessary"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			t.Parallel()
+			if sc.isKnown(tt.word) {
+				t.Fatalf("misspelling %q is in the dictionary", tt.word)
+			}
+			if got, ok := sc.suggest(tt.word); !ok || got != tt.want {
+				t.Errorf("expected suggestion %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,235 @@
+package pkg
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"math"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+// UniqueMessagesAnalyzer checks the uniqueness of log messages for LogsAnalyzer, which reports its results.
+// It exports the package messages as a fact, and facts make the driver analyze every dependency of the
+// checked packages, so LogsAnalyzer requires it only when enable_unique_messages is on.
+var UniqueMessagesAnalyzer = &analysis.Analyzer{
+	Name: "logsunique",
+	Doc:  "Collects log messages to check their uniqueness across packages",
+	Run: func(pass *analysis.Pass) (interface{}, error) {
+		return runUniqueMessages(pass, MySettings{Config: configPath})
+	},
+	Requires:   []*analysis.Analyzer{inspect.Analyzer},
+	FactTypes:  []analysis.Fact{new(messagesFact)},
+	ResultType: reflect.TypeFor[uniqueMessagesResult](),
+}
+
+// uniqueMessagesResult holds the diagnostics of the uniqueness check, reported by LogsAnalyzer.
+type uniqueMessagesResult []analysis.Diagnostic
+
+// maxListedLocations limits how many other locations of a duplicate message are listed in a diagnostic.
+const maxListedLocations = 3
+
+// messagesFact is exported for every package and holds the locations of its constant log messages,
+// so packages importing it can report messages that are not unique across the codebase.
+type messagesFact struct {
+	// Messages maps a log message to its locations, formatted as "import/path/file.go:line".
+	Messages map[string][]string
+}
+
+// AFact marks messagesFact as an analysis fact.
+func (*messagesFact) AFact() {}
+
+// String returns a short description of the fact for debugging.
+func (f *messagesFact) String() string {
+	return fmt.Sprintf("messages(%d)", len(f.Messages))
+}
+
+// messageSite is a constant log message and its position.
+type messageSite struct {
+	msg string
+	pos token.Pos
+}
+
+// constantMessage returns the constant value of the log message, if the message is a constant string.
+func constantMessage(pass *analysis.Pass, lc *logCall) (string, bool) {
+	msg := lc.message()
+	if msg == nil {
+		return "", false
+	}
+	value, ok := constantString(pass, msg)
+	return value, ok && strings.TrimSpace(value) != ""
+}
+
+// runUniqueMessages collects the constant log messages of the package and checks their uniqueness.
+func runUniqueMessages(pass *analysis.Pass, settings MySettings) (interface{}, error) {
+	cfg, err := ResolveConfig(settings.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	insp := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	var sites []messageSite
+	insp.Preorder([]ast.Node{(*ast.CallExpr)(nil)}, func(n ast.Node) {
+		if lc, ok := parseLogCall(pass, n.(*ast.CallExpr)); ok {
+			sites = collectMessageSite(pass, lc, sites)
+		}
+	})
+	return checkMessageUniqueness(pass, sites, cfg.MessageSimilarityThreshold), nil
+}
+
+// checkMessageUniqueness finds log messages used more than once in the package or already used
+// in one of its dependencies, and, with a positive threshold, messages whose normalized edit distance
+// to another message is below it. It exports the package messages as a fact for dependent packages.
+// Packages that do not import each other never see each other's messages.
+func checkMessageUniqueness(pass *analysis.Pass, sites []messageSite, threshold float64) uniqueMessagesResult {
+	known := make(map[string][]string)
+	for _, fact := range pass.AllPackageFacts() {
+		if mf, ok := fact.Fact.(*messagesFact); ok && fact.Package != pass.Pkg {
+			for msg, locations := range mf.Messages {
+				known[msg] = append(known[msg], locations...)
+			}
+		}
+	}
+	lengths := make(messagesByLength)
+	for msg, locations := range known {
+		sort.Strings(locations)
+		lengths.add(msg)
+	}
+
+	var diags uniqueMessagesResult
+	own := make(map[string][]string)
+	for _, site := range sites {
+		location := siteLocation(pass, site.pos)
+
+		if locations := slices.Concat(known[site.msg], own[site.msg]); len(locations) > 0 {
+			diags = append(diags, analysis.Diagnostic{
+				Pos:     site.pos,
+				Message: fmt.Sprintf("log message %q is not unique, it is also used at %s", site.msg, formatLocations(locations)),
+			})
+		} else if threshold > 0 {
+			if similar, locations, ok := nearDuplicate(site.msg, known, own, lengths, threshold); ok {
+				diags = append(diags, analysis.Diagnostic{
+					Pos:     site.pos,
+					Message: fmt.Sprintf("log message %q is nearly identical to %q used at %s", site.msg, similar, formatLocations(locations)),
+				})
+			}
+		}
+
+		if _, seen := known[site.msg]; !seen && len(own[site.msg]) == 0 {
+			lengths.add(site.msg)
+		}
+		own[site.msg] = append(own[site.msg], location)
+	}
+
+	if len(own) > 0 {
+		pass.ExportPackageFact(&messagesFact{Messages: own})
+	}
+	return diags
+}
+
+// messagesByLength groups messages by their length in runes.
+type messagesByLength map[int][]string
+
+// add puts msg into the group of its length.
+func (b messagesByLength) add(msg string) {
+	n := utf8.RuneCountInString(msg)
+	b[n] = append(b[n], msg)
+}
+
+// within returns the messages whose length differs from n so little that their normalized
+// edit distance to a message of length n can be below the threshold. The edit distance is at least
+// the length difference, so longer and shorter messages are skipped without comparing them.
+func (b messagesByLength) within(n int, threshold float64) []string {
+	var msgs []string
+	if threshold >= 1 {
+		for _, group := range b {
+			msgs = append(msgs, group...)
+		}
+		return msgs
+	}
+
+	shortest := int(float64(n) * (1 - threshold))
+	longest := int(math.Ceil(float64(n) / (1 - threshold)))
+	for length := shortest; length <= longest; length++ {
+		msgs = append(msgs, b[length]...)
+	}
+	return msgs
+}
+
+// nearDuplicate finds a known message whose normalized edit distance to msg is below the threshold.
+// Only messages of a similar length, taken from lengths, are compared with msg.
+func nearDuplicate(msg string, known, own map[string][]string, lengths messagesByLength, threshold float64) (string, []string, bool) {
+	candidates := lengths.within(utf8.RuneCountInString(msg), threshold)
+	sort.Strings(candidates)
+
+	for _, other := range candidates {
+		if normalizedDistance(msg, other) < threshold {
+			return other, slices.Concat(known[other], own[other]), true
+		}
+	}
+	return "", nil, false
+}
+
+// normalizedDistance returns the Levenshtein distance between a and b divided by the length
+// of the longer string, so 0 means equal and 1 means completely different.
+func normalizedDistance(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+	longest := max(len(ra), len(rb))
+	if longest == 0 {
+		return 0
+	}
+	return float64(editDistance(ra, rb)) / float64(longest)
+}
+
+// editDistance returns the Levenshtein distance between two rune slices.
+func editDistance(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
+
+// siteLocation formats a position as "import/path/file.go:line", independent of the checkout directory.
+func siteLocation(pass *analysis.Pass, pos token.Pos) string {
+	position := pass.Fset.Position(pos)
+	return fmt.Sprintf("%s/%s:%d", pass.Pkg.Path(), filepath.Base(position.Filename), position.Line)
+}
+
+// formatLocations lists the first locations of a message, mentioning how many more there are.
+func formatLocations(locations []string) string {
+	if len(locations) <= maxListedLocations {
+		return strings.Join(locations, ", ")
+	}
+	return fmt.Sprintf("%s and %d more", strings.Join(locations[:maxListedLocations], ", "), len(locations)-maxListedLocations)
+}
+
+// collectMessageSite remembers the constant message of the log call for the uniqueness check.
+func collectMessageSite(pass *analysis.Pass, lc *logCall, sites []messageSite) []messageSite {
+	msg, ok := constantMessage(pass, lc)
+	if !ok {
+		return sites
+	}
+	return append(sites, messageSite{msg: msg, pos: lc.message().Pos()})
+}
@@ -0,0 +1,80 @@
+package pkg
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestNormalizedDistance tests the normalizedDistance function
+// to ensure near-duplicate messages get a small distance
+func TestNormalizedDistance(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want float64
+	}{
+		{
+			name: "equal",
+			a:    "failed to process request",
+			b:    "failed to process request",
+			want: 0,
+		},
+		{
+			name: "one_typo",
+			a:    "connection refused",
+			b:    "conection refused",
+			want: 1.0 / 18,
+		},
+		{
+			name: "different",
+			a:    "abc",
+			b:    "xyz",
+			want: 1,
+		},
+		{
+			name: "empty",
+			a:    "",
+			b:    "",
+			want: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := normalizedDistance(tt.a, tt.b)
+
+			if got != tt.want {
+				t.Errorf("expected %v, got %v: %s", tt.want, got, tt.name)
+			}
+		})
+	}
+}
+
+// TestMessagesByLengthWithin tests the messagesByLength.within method
+// to ensure no message that can be a near duplicate is skipped
+func TestMessagesByLengthWithin(t *testing.T) {
+	lengths := make(messagesByLength)
+	for n := 1; n <= 60; n++ {
+		lengths.add(strings.Repeat("a", n))
+	}
+
+	for _, threshold := range []float64{0.1, 0.25, 0.5, 0.9, 1} {
+		for n := 1; n <= 30; n++ {
+			got := make(map[int]bool)
+			for _, msg := range lengths.within(n, threshold) {
+				got[len(msg)] = true
+			}
+			for m := 1; m <= 60; m++ {
+				bound := float64(max(n, m)-min(n, m)) / float64(max(n, m))
+				if bound < threshold && !got[m] {
+					t.Errorf("threshold %v, length %d: message of length %d skipped", threshold, n, m)
+				}
+			}
+			if threshold == 0.1 && n == 10 && got[20] {
+				t.Errorf("threshold %v, length %d: message of length 20 not skipped", threshold, n)
+			}
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package bannedphrases
+
+import (
+	"log"
+	"log/slog"
+
+	"go.uber.org/zap"
+)
+
+func messages(logger *zap.Logger, sugar *zap.SugaredLogger, name string) {
+	logger.Info("couldn't connect to database")         // want `log message contains banned phrase "couldn't"; use "failed to" instead`
+	logger.Info("Could not open file")                  // want `log message contains banned phrase "Could not"; use "failed to" instead`
+	logger.Info("cant parse config")                    // want `log message contains banned phrase "cant"; use "cannot" instead`
+	logger.Info("user saved successfully successfully") // want `log message contains banned phrase "successfully successfully"; use "successfully" instead`
+	logger.Warn("retry pls")                            // want `log message contains banned phrase "pls"`
+	logger.Info("deploying project-falcon")             // want `log message contains banned phrase "project-falcon"`
+	logger.Error("request err, cant retry")             // want `log message contains banned phrase "err"; use "error" instead` `log message contains banned phrase "cant"; use "cannot" instead`
+
+	logger.Info("failed to connect to database")
+	logger.Error("error while parsing request")
+	logger.Info("the canteen is open")
+
+	sugar.Infof("couldn't load %s", name) // want `log message contains banned phrase "couldn't"; use "failed to" instead`
+	slog.Info(`couldn't reach "peer"`)    // want `log message contains banned phrase "couldn't"; use "failed to" instead`
+	log.Print("couldn't\tstart")          // want `log message contains banned phrase "couldn't"; use "failed to" instead`
+}
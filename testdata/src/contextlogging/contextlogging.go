@@ -0,0 +1,33 @@
+package contextlogging
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+
+	"github.com/sirupsen/logrus"
+)
+
+func handle(ctx context.Context, logger *slog.Logger, id string) {
+	slog.Info("handling request", "id", id)         // want "use InfoContext with ctx, since a context.Context is in scope"
+	logger.Error("failed to handle request")        // want "use ErrorContext with ctx, since a context.Context is in scope"
+	logger.InfoContext(ctx, "request handled")      // ok
+	logrus.Info("handling request")                 // want `use WithContext\(ctx\), since a context.Context is in scope`
+	logrus.WithContext(ctx).Info("request handled") // ok
+}
+
+func serve(w http.ResponseWriter, r *http.Request) {
+	slog.Info("serving request") // ok
+
+	reqCtx := r.Context()
+	_ = reqCtx
+	slog.Warn("slow request") // want "use WarnContext with reqCtx, since a context.Context is in scope"
+}
+
+func background() {
+	slog.Debug("tick") // ok
+
+	go func(ctx context.Context) {
+		slog.Debug("tick in worker") // want "use DebugContext with ctx, since a context.Context is in scope"
+	}(context.Background())
+}
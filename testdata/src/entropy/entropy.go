@@ -0,0 +1,26 @@
+package entropy
+
+import (
+	"log/slog"
+
+	"go.uber.org/zap"
+)
+
+func messages(logger *zap.Logger, sugar *zap.SugaredLogger, id string) {
+	logger.Info("signing with q8Zr3LmN5vXw2KpT7yBj4HcF")                      // want `log message contains high-entropy base64 string`
+	logger.Info("digest da39a3ee5e6b4b0d3255bfef95601890afd80709 stored")     // want `log message contains high-entropy hex string`
+	logger.Info("calling", zap.String("key", "q8Zr3LmN5vXw2KpT7yBj4HcF"))     // want `log argument contains high-entropy base64 string`
+	slog.Info("upstream configured", "credential", "Zx9Qw8Er7Ty6Ui5Op4As3Df") // want `log argument contains high-entropy base64 string`
+
+	logger.Info("request 550e8400-e29b-41d4-a716-446655440000 handled", zap.String("id", id)) // want `log message contains UUID \(low confidence\)`
+	logger.Info("running TestSomethingVeryLongName")
+	logger.Info("connection_pool_exhausted_again")
+	logger.Info("padding AAAAAAAAAAAAAAAAAAAAAAAA")
+	logger.Info("short q8Zr3LmN5vX")
+	logger.Info("signing with q8Zr3LmN5vXw2KpT7yBj") // want `log message contains high-entropy base64 string`
+	logger.Info("signing with q8Zr3LmN5vXw2KpT7yB")
+	logger.Info("order 12345678901234567890 shipped")
+	logger.Info("order 98172634509182736450 shipped")
+	logger.Info("digest 9f86d081884c7d659a2feaa0c55ad015 stored") // want `log message contains high-entropy hex string`
+	sugar.Infof("value %sq8Zr3LmN5vXw2KpT7yBj4HcF", id)
+}
@@ -0,0 +1,48 @@
+package errorattr
+
+import (
+	"errors"
+	"log/slog"
+
+	"github.com/sirupsen/logrus"
+	"go.uber.org/zap"
+)
+
+func save() error { return errors.New("failed") }
+
+func testZap(logger *zap.Logger) {
+	if err := save(); err != nil {
+		logger.Error("failed to save order")                        // want "Error-level log call should include the error err"
+		logger.Error("failed to save order", zap.String("id", "1")) // want "Error-level log call should include the error err"
+		logger.Error("failed to save order", zap.Error(err))        // ok
+		logger.Info("retrying")                                     // ok
+	}
+
+	logger.Error("failed to save order") // ok
+}
+
+func testSlog(logger *slog.Logger) {
+	err := save()
+	if err != nil {
+		logger.Error("failed to save order")                       // want "Error-level log call should include the error err"
+		logger.Error("failed to save order", slog.Any("err", err)) // ok
+		slog.Error("failed to save order", "err", err)             // ok
+		if true {
+			slog.Error("failed to save order") // want "Error-level log call should include the error err"
+		}
+	} else {
+		slog.Error("unexpected success") // ok
+	}
+}
+
+func testSugarAndLogrus(sugar *zap.SugaredLogger) {
+	if err := save(); err != nil {
+		sugar.Errorw("failed to save order", "id", 1)       // want "Error-level log call should include the error err"
+		sugar.Errorf("failed to save order: %v", err)       // ok
+		logrus.Error("failed to save order")                // want "Error-level log call should include the error err"
+		logrus.WithError(err).Error("failed to save order") // ok
+		go func() {
+			logrus.Error("failed in background") // ok
+		}()
+	}
+}
@@ -0,0 +1,31 @@
+package errorstring
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/sirupsen/logrus"
+	"go.uber.org/zap"
+)
+
+var errNotFound = errors.New("not found")
+
+func testZap(logger *zap.Logger, err error) {
+	logger.Error("failed to save", zap.String("error", err.Error()))    // want `log call should pass the error err as a typed field instead of err.Error\(\)`
+	logger.Error("failed to save", zap.String("cause", err.Error()))    // want `log call should pass the error err as a typed field instead of err.Error\(\)`
+	logger.Error("failed to save: " + err.Error())                      // want `log call should pass the error err as a typed field instead of err.Error\(\)`
+	logger.Error(fmt.Sprintf("failed to save %s", errNotFound.Error())) // want `log call should pass the error errNotFound as a typed field instead of errNotFound.Error\(\)`
+	logger.Error("failed to save", zap.Error(err))                      // ok
+}
+
+func testSlog(err error) {
+	slog.Error("failed to save", slog.String("err", err.Error())) // want `log call should pass the error err as a typed field instead of err.Error\(\)`
+	slog.Error("failed to save", "err", err.Error())              // want `log call should pass the error err as a typed field instead of err.Error\(\)`
+	slog.Error("failed to save", "err", err)                      // ok
+}
+
+func testSugarAndLogrus(sugar *zap.SugaredLogger, err error) {
+	sugar.Errorf("failed to save: %s", err.Error()) // want `log call should pass the error err as a typed field instead of err.Error\(\)`
+	logrus.Error("failed to save: " + err.Error())  // want `log call should pass the error err as a typed field instead of err.Error\(\)`
+}
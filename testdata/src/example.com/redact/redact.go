@@ -0,0 +1,6 @@
+package redact
+
+// String masks a sensitive string or byte slice.
+func String[T ~string | ~[]byte](s T) string {
+	return "***"
+}
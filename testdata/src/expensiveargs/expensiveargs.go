@@ -0,0 +1,33 @@
+package expensiveargs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"go.uber.org/zap"
+)
+
+type request struct {
+	Body []byte
+}
+
+func mustMarshal(v interface{}) []byte {
+	data, _ := json.Marshal(v)
+	return data
+}
+
+func handle(ctx context.Context, logger *zap.Logger, slogger *slog.Logger, req request) {
+	logger.Debug("payload", zap.String("body", string(mustMarshal(req)))) // want `Debug-level log call evaluates mustMarshal\(req\) even when debug logging is disabled; use zap.Stringer or zap.Object for lazy formatting or guard the call with logger.Check`
+	slogger.Debug("payload", "body", fmt.Sprintf("%x", req.Body))         // want `Debug-level log call evaluates fmt.Sprintf\("%x", req.Body\) even when debug logging is disabled; implement slog.LogValuer for lazy formatting or guard the call with logger.Enabled`
+	logger.Debug("payload", zap.Int("size", len(req.Body)))               // ok
+	logger.Info("payload", zap.String("body", string(mustMarshal(req))))  // ok
+
+	if slogger.Enabled(ctx, slog.LevelDebug) {
+		slogger.Debug("payload", "body", string(mustMarshal(req))) // ok
+	}
+	if ce := logger.Check(zap.DebugLevel, "payload"); ce != nil {
+		logger.Debug("payload", zap.String("body", string(mustMarshal(req)))) // ok
+	}
+}
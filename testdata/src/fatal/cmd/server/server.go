@@ -0,0 +1,9 @@
+package server
+
+import (
+	"log"
+)
+
+func Run(err error) {
+	log.Fatalf("server stopped: %v", err) // ok
+}
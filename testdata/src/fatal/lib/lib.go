@@ -0,0 +1,21 @@
+package lib
+
+import (
+	"log"
+
+	"github.com/sirupsen/logrus"
+	"go.uber.org/zap"
+	"k8s.io/klog/v2"
+)
+
+func load(logger *zap.Logger, err error) {
+	logger.Fatal("failed to load config")        // want "Fatal-level log call outside package main; return an error to the caller instead"
+	logger.Panic("failed to load config")        // want "Panic-level log call outside package main; return an error to the caller instead"
+	log.Fatalf("failed to load config: %v", err) // want "Fatal-level log call outside package main; return an error to the caller instead"
+	log.Panicln("failed to load config")         // want "Panic-level log call outside package main; return an error to the caller instead"
+	klog.Fatal("failed to load config")          // want "Fatal-level log call outside package main; return an error to the caller instead"
+	klog.Exit("failed to load config")           // want "Fatal-level log call outside package main; return an error to the caller instead"
+	logrus.Panicf("failed to load %s", "config") // want "Panic-level log call outside package main; return an error to the caller instead"
+	logger.Error("failed to load config")        // ok
+	klog.ErrorS(err, "failed to load config")    // ok
+}
@@ -0,0 +1,9 @@
+package main
+
+import (
+	"k8s.io/klog/v2"
+)
+
+func main() {
+	klog.Fatal("tool failed") // ok
+}
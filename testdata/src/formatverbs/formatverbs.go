@@ -0,0 +1,50 @@
+package formatverbs
+
+import (
+	"log"
+	"log/slog"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+type (
+	UserID int64
+	Status string
+)
+
+type user struct {
+	ID   int64
+	Name string
+}
+
+func testSlog(name string, u user, took time.Duration, load int) {
+	slog.Info("user %s logged in", name)                   // want "log message contains format verb %s, but Info does not format its arguments"
+	slog.Warn("user %d renamed to %q", u.ID, u.Name)       // want "log message contains format verb %d, but Warn does not format its arguments"
+	slog.Debug("request took %v", took, "ok", true)        // want "log message contains format verb %v, but Debug does not format its arguments"
+	slog.Info("load 100%d", load)                          // want "log message contains format verb %d, but Info does not format its arguments"
+	slog.Info("user %s logged in", "user", name)           // want "log message contains format verb %s, but Info does not format its arguments"
+	slog.Info("user logged in", slog.String("name", name)) // ok
+	slog.Info("disk is 100% full")                         // ok
+	slog.Info("progress 50%%")                             // ok
+}
+
+func testZap(d int, userName string) {
+	logger := zap.L()
+	logger.Info("took %dms", zap.Int("d", d)) // want "log message contains format verb %d, but Info does not format its arguments"
+	logger.Info("took long", zap.Int("d", d)) // ok
+
+	sugar := zap.S()
+	sugar.Info("user %s logged in", userName)  // want "log message contains format verb %s, but Info does not format its arguments"
+	sugar.Infof("user %s logged in", userName) // ok
+}
+
+func testStdLog(port int) {
+	log.Print("listening on %d", port)  // want "log message contains format verb %d, but Print does not format its arguments"
+	log.Printf("listening on %d", port) // ok
+}
+
+func testNamedTypes(id UserID, status Status, u *user) {
+	slog.Info("user %d is %s", id, status) // want "log message contains format verb %d, but Info does not format its arguments"
+	zap.S().Warn("user %v not found", u)   // want "log message contains format verb %v, but Warn does not format its arguments"
+}
@@ -0,0 +1,81 @@
+package logrus
+
+import "context"
+
+type Fields map[string]interface{}
+
+type Logger struct{}
+
+type Entry struct{}
+
+func New() *Logger { return &Logger{} }
+
+func StandardLogger() *Logger { return &Logger{} }
+
+func WithField(key string, value interface{}) *Entry { return &Entry{} }
+
+func WithFields(fields Fields) *Entry { return &Entry{} }
+
+func WithError(err error) *Entry { return &Entry{} }
+
+func WithContext(ctx context.Context) *Entry { return &Entry{} }
+
+func Debug(args ...interface{}) {}
+
+func Info(args ...interface{}) {}
+
+func Warn(args ...interface{}) {}
+
+func Error(args ...interface{}) {}
+
+func Fatal(args ...interface{}) {}
+
+func Panic(args ...interface{}) {}
+
+func Debugf(format string, args ...interface{}) {}
+
+func Infof(format string, args ...interface{}) {}
+
+func Warnf(format string, args ...interface{}) {}
+
+func Errorf(format string, args ...interface{}) {}
+
+func Fatalf(format string, args ...interface{}) {}
+
+func Panicf(format string, args ...interface{}) {}
+
+func (l *Logger) WithField(key string, value interface{}) *Entry { return &Entry{} }
+
+func (l *Logger) WithError(err error) *Entry { return &Entry{} }
+
+func (l *Logger) WithContext(ctx context.Context) *Entry { return &Entry{} }
+
+func (l *Logger) Info(args ...interface{}) {}
+
+func (l *Logger) Error(args ...interface{}) {}
+
+func (l *Logger) Infof(format string, args ...interface{}) {}
+
+func (l *Logger) Errorf(format string, args ...interface{}) {}
+
+func (e *Entry) WithField(key string, value interface{}) *Entry { return e }
+
+func (e *Entry) WithError(err error) *Entry { return e }
+
+func (e *Entry) WithContext(ctx context.Context) *Entry { return e }
+
+func (e *Entry) Debug(args ...interface{}) {}
+
+func (e *Entry) Info(args ...interface{}) {}
+
+func (e *Entry) Warn(args ...interface{}) {}
+
+func (e *Entry) Error(args ...interface{}) {}
+
+func (e *Entry) Infof(format string, args ...interface{}) {}
+
+func (e *Entry) Errorf(format string, args ...interface{}) {}
+
+func (l *Logger) WithFields(fields Fields) *Entry { return &Entry{} }
+
+func (e *Entry) WithFields(fields Fields) *Entry { return e }
@@ -0,0 +1,9 @@
+package app
+
+import (
+	"log/slog"
+)
+
+func Run() {
+	slog.Info("starting app") // ok
+}
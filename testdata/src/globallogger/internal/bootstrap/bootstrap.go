@@ -0,0 +1,9 @@
+package bootstrap
+
+import (
+	"go.uber.org/zap"
+)
+
+func Logger() *zap.Logger {
+	return zap.L() // ok
+}
@@ -0,0 +1,31 @@
+package service
+
+import (
+	"log"
+	"log/slog"
+
+	"github.com/sirupsen/logrus"
+	"go.uber.org/zap"
+)
+
+type Service struct {
+	logger *slog.Logger
+	zap    *zap.Logger
+}
+
+func New() *Service {
+	return &Service{
+		logger: slog.Default(), // want `use of global logger slog.Default; inject a logger instead`
+		zap:    zap.L(),        // want `use of global logger zap.L; inject a logger instead`
+	}
+}
+
+func (s *Service) Run(port int) {
+	slog.Info("starting service")             // want `use of global logger slog.Info; inject a logger instead`
+	log.Printf("listening on %d", port)       // want `use of global logger log.Printf; inject a logger instead`
+	logrus.Info("starting service")           // want `use of global logger logrus.Info; inject a logger instead`
+	logrus.WithField("port", port).Info("up") // want `use of global logger logrus.WithField; inject a logger instead`
+	zap.S().Infof("listening on %d", port)    // want `use of global logger zap.S; inject a logger instead`
+	s.logger.Info("starting service")         // ok
+	s.zap.Info("starting service")            // ok
+}
@@ -0,0 +1,35 @@
+package klog
+
+type Verbose bool
+
+func V(level int) Verbose { return Verbose(true) }
+
+func (v Verbose) Enabled() bool { return bool(v) }
+
+func (v Verbose) Info(args ...interface{}) {}
+
+func (v Verbose) Infof(format string, args ...interface{}) {}
+
+func (v Verbose) InfoS(msg string, keysAndValues ...interface{}) {}
+
+func Info(args ...interface{}) {}
+
+func Infof(format string, args ...interface{}) {}
+
+func InfoS(msg string, keysAndValues ...interface{}) {}
+
+func Warning(args ...interface{}) {}
+
+func Warningf(format string, args ...interface{}) {}
+
+func Error(args ...interface{}) {}
+
+func Errorf(format string, args ...interface{}) {}
+
+func ErrorS(err error, msg string, keysAndValues ...interface{}) {}
+
+func Fatal(args ...interface{}) {}
+
+func Fatalf(format string, args ...interface{}) {}
+
+func Exit(args ...interface{}) {}
@@ -0,0 +1,79 @@
+package logandreturn
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"go.uber.org/zap"
+)
+
+func save() error { return errors.New("failed") }
+
+func saveOrder(logger *zap.Logger) error {
+	if err := save(); err != nil {
+		logger.Error("failed to save order", zap.Error(err)) // want "error err is logged and also returned at line 16; handle it once"
+		return err
+	}
+	return nil
+}
+
+func saveWrapped() error {
+	err := save()
+	if err != nil {
+		slog.Error("failed to save order", "err", err) // want "error err is logged and also returned at line 26; handle it once"
+		cleanup()
+		return fmt.Errorf("save order: %w", err)
+	}
+	return nil
+}
+
+func saveHandled() error {
+	if err := save(); err != nil {
+		slog.Error("failed to save order", "err", err) // ok
+		return nil
+	}
+	return nil
+}
+
+func saveReturnOther() error {
+	if err := save(); err != nil {
+		slog.Error("failed to save order", "err", err) // ok
+		if retry() {
+			return err
+		}
+	}
+	return nil
+}
+
+func saveInClosure() error {
+	err := save()
+	go func() {
+		slog.Error("failed to save order", "err", err) // ok
+	}()
+	return err
+}
+
+func main() {
+	_ = func() error {
+		if err := save(); err != nil {
+			slog.Error("failed to save order", "err", err) // ok
+			return err
+		}
+		return nil
+	}()
+}
+
+type OrderHandler struct{}
+
+func (h *OrderHandler) Handle() error {
+	if err := save(); err != nil {
+		slog.Error("failed to save order", "err", err) // ok
+		return err
+	}
+	return nil
+}
+
+func cleanup() {}
+
+func retry() bool { return true }
@@ -0,0 +1,32 @@
+package logandreturndefault
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+)
+
+func save() error { return errors.New("failed") }
+
+type handler struct{}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	run := func() error {
+		if err := save(); err != nil {
+			slog.Error("failed to save order", "err", err) // ok
+			return err
+		}
+		return nil
+	}
+	if run() != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+func (h *handler) Handle() error {
+	if err := save(); err != nil {
+		slog.Error("failed to save order", "err", err) // want "error err is logged and also returned at line 29; handle it once"
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package looplogging
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"go.uber.org/zap"
+	"k8s.io/klog/v2"
+	"looplogging/sampler"
+)
+
+type validator struct{}
+
+func (validator) Check(item string) error {
+	if item == "" {
+		return errors.New("empty item")
+	}
+	return nil
+}
+
+func process(ctx context.Context, logger *slog.Logger, zlogger *zap.Logger, v validator, items []string) {
+	for _, item := range items {
+		logger.Info("processing item", "item", item)  // want "Info-level log call inside a loop; guard it with a level check or sampling"
+		logger.Debug("processing item", "item", item) // ok
+		if logger.Enabled(ctx, slog.LevelInfo) {
+			logger.Info("processing item", "item", item) // ok
+		}
+		if ce := zlogger.Check(zap.InfoLevel, "processing item"); ce != nil {
+			zlogger.Info("processing item") // ok
+		}
+		if sampler.Allow() {
+			logger.Warn("slow item", "item", item) // ok
+		}
+		var s sampler.Sometimes
+		s.Do(func() {
+			logger.Warn("slow item", "item", item) // ok
+		})
+		klog.V(2).Info("processing item") // ok
+		if klog.V(2).Enabled() {
+			logger.Info("processing item", "item", item) // ok
+		}
+		if v.Check(item) == nil {
+			logger.Info("processing item", "item", item) // want "Info-level log call inside a loop; guard it with a level check or sampling"
+		}
+	}
+
+	for i := 0; i < len(items); i++ {
+		zlogger.Error("failed to process item") // want "Error-level log call inside a loop; guard it with a level check or sampling"
+		go func() {
+			zlogger.Warn("retrying item") // want "Warn-level log call inside a loop; guard it with a level check or sampling"
+		}()
+	}
+
+	logger.Info("processed items") // ok
+}
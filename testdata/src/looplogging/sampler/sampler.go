@@ -0,0 +1,7 @@
+package sampler
+
+func Allow() bool { return true }
+
+type Sometimes struct{}
+
+func (s *Sometimes) Do(f func()) { f() }
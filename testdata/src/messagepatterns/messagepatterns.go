@@ -0,0 +1,29 @@
+package messagepatterns
+
+import (
+	"log/slog"
+
+	"github.com/sirupsen/logrus"
+	"go.uber.org/zap"
+)
+
+const msgCharge = "charge declined"
+
+func messages(logger *zap.Logger, sugar *zap.SugaredLogger, err error) {
+	logger.Error("failed to open file", zap.Error(err))
+	logger.Error("cannot reach upstream")
+	logger.Error("upstream unreachable") // want "Error-level log message \"upstream unreachable\" should match one of `\\^failed to `, `\\^cannot `"
+	logger.Error(msgCharge)              // want "Error-level log message \"charge declined\" should match one of `\\^failed to `, `\\^cannot `"
+	logger.DPanic("invariant broken")    // want "Error-level log message \"invariant broken\" should match one of `\\^failed to `, `\\^cannot `"
+
+	logger.Info("request served")
+	logger.Info("Error budget exhausted") // want "Info-level log message \"Error budget exhausted\" should not match `\\(\\?i\\)\\\\berror\\\\b`"
+	logger.Info("errors are counted")
+	sugar.Infof("retry error for %s", "peer") // want "Info-level log message \"retry error for %s\" should not match `\\(\\?i\\)\\\\berror\\\\b`"
+
+	slog.Warn("disk almost full")
+	slog.Warn("Disk almost full!") // want "Warn-level log message \"Disk almost full!\" should match `\\^\\[a-z\\]`" "Warn-level log message \"Disk almost full!\" should not match `\\[.!\\]\\$`"
+	logrus.Warnf("slow query.")    // want "Warn-level log message \"slow query.\" should not match `\\[.!\\]\\$`"
+
+	logger.Debug("Anything goes here.")
+}
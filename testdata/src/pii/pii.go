@@ -0,0 +1,35 @@
+package pii
+
+import (
+	"log/slog"
+
+	"go.uber.org/zap"
+)
+
+const supportEmail = "support@example.com"
+
+func messages(logger *zap.Logger, sugar *zap.SugaredLogger, id string) {
+	logger.Info("charged card 4111 1111 1111 1111")      // want `log message contains credit card number`
+	logger.Info("charged card 4111-1111-1111-1111")      // want `log message contains credit card number`
+	logger.Info("order 4111111111111112 shipped")        // Luhn checksum fails
+	logger.Info("payout to DE89370400440532013000")      // want `log message contains IBAN`
+	logger.Info("payout to GB82 WEST 1234 5698 7654 32") // want `log message contains IBAN`
+	logger.Info("payout to DE89370400440532013001")      // IBAN checksum fails
+	logger.Info("calling +14155552671")                  // want `log message contains phone number`
+	logger.Info("calling 4155552671")                    // not in E.164 form
+	logger.Info("sent mail to john.doe@example.com")     // want `log message contains email address`
+	logger.Info("mail delivered to user@localhost")
+	logger.Info("peer 203.0.113.7 connected") // want `log message contains IP address`
+	logger.Info("peer 2001:db8::1 connected") // want `log message contains IP address`
+	logger.Info("listening on 127.0.0.1 and ::1")
+	logger.Info("peer 999.1.1.1 connected")
+	logger.Info("job started at 12:30:45")
+	logger.Info("calling Cache::get failed")
+	logger.Info("std::vector overflow")
+	logger.Info("schema 2001:db8::1f0x rejected")
+	logger.Info(supportEmail) // want `log message contains email address`
+
+	logger.Info("user signed up", zap.String("email", "jane@example.org"), zap.String("id", id)) // want `log argument contains email address`
+	slog.Info("request received", "ip", "198.51.100.4")                                          // want `log argument contains IP address`
+	sugar.Infof("notifying %s", "ops@example.net")                                               // want `log argument contains email address`
+}
@@ -0,0 +1,65 @@
+package printfcheck
+
+import (
+	"errors"
+	"log"
+
+	"github.com/sirupsen/logrus"
+	"go.uber.org/zap"
+)
+
+type userID int
+
+type point struct {
+	X, Y int
+}
+
+type status struct{}
+
+func (status) String() string { return "ok" }
+
+type auditLogger struct{}
+
+func (auditLogger) Logf(level int, format string, args ...interface{}) {}
+
+func audit(format string, args ...interface{}) {}
+
+func testSugar(name string, count int, id userID, ratio float64, st status) {
+	sugar := zap.S()
+	sugar.Infof("%d users", count)           // ok
+	sugar.Infof("%d users", name)            // want `Infof format %d has arg name of wrong type string`
+	sugar.Infof("%s took %.2f", name, ratio) // ok
+	sugar.Infof("%s took %d", name, ratio)   // want `Infof format %d has arg ratio of wrong type float64`
+	sugar.Infof("user %d", id)               // ok
+	sugar.Infof("status %s", st)             // ok
+	sugar.Infof("point %d", point{1, 2})     // ok
+	sugar.Infof("%s %s", name)               // want `Infof format %s reads arg #2, but call has 1 arg`
+	sugar.Infof("user %s", name, count)      // want `Infof call needs 1 arg but has 2 args`
+	sugar.Infof("%[2]s %[1]d", count, name)  // ok
+	sugar.Infof("%*d", count, count)         // ok
+	sugar.Infof("%*d", name, count)          // want `Infof format %\*d uses non-int name as argument of \*`
+	sugar.Infof("done 100%%")                // ok
+}
+
+func testLogrus(a string, err error) {
+	logrus.Errorf("%s %s", a)               // want `Errorf format %s reads arg #2, but call has 1 arg`
+	logrus.Errorf("failed: %w", err)        // want `Errorf does not support error-wrapping directive %w`
+	logrus.Errorf("failed: %v", err)        // ok
+	logrus.Infof("enabled %t", a)           // want `Infof format %t has arg a of wrong type string`
+	logrus.WithField("k", 1).Infof("%d", a) // want `Infof format %d has arg a of wrong type string`
+}
+
+func testStdLog(args []interface{}, err error) {
+	log.Printf("%s %s", args...)      // ok
+	log.Printf("error: %s", err)      // ok
+	log.Printf("done%")               // want `Printf format % is missing verb at end of string`
+	log.Printf("value %y", 1)         // want `Printf format %y has unknown verb y`
+	log.Printf("%s", errors.New("x")) // ok
+}
+
+func testDeclared(name string) {
+	var l auditLogger
+	l.Logf(1, "user %d", name) // want `Logf format %d has arg name of wrong type string`
+	l.Logf(1, "user %s", name) // ok
+	audit("%s %s", name)       // want `audit format %s reads arg #2, but call has 1 arg`
+}
@@ -0,0 +1,10 @@
+package redaction
+
+import (
+	"log/slog"
+)
+
+func withoutHelperImport(pwd string) {
+	slog.Info("login", "password", pwd) // want `log field "password" contains sensitive keyword "password"`
+	slog.Info("user password: " + pwd)  // want `log message attaches a value to sensitive keyword "password"`
+}
@@ -0,0 +1,10 @@
+package redaction
+
+import (
+	"log/slog"
+)
+
+func helperNameTaken(pwd string) {
+	redact := "on"
+	slog.Info("login", "password", pwd, "redact", redact) // want `log field "password" contains sensitive keyword "password"`
+}
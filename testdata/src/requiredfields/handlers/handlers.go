@@ -0,0 +1,108 @@
+package handlers
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/sirupsen/logrus"
+	"go.uber.org/zap"
+)
+
+const requestIDKey = "request_id"
+
+func zapFields(id string) {
+	logger := zap.L()
+	logger.Info("request started") // want `Info-level log call is missing required fields request_id`
+	logger.Info("request started", zap.String("request_id", id))
+	logger.Info("request started", zap.String(requestIDKey, id))
+	logger.With(zap.String("request_id", id)).Info("request started")
+	logger.Debug("request started", zap.String("path", "/")) // want `Debug-level log call is missing required fields request_id`
+}
+
+func zapWithVariable(id string) {
+	logger := zap.L()
+	reqLogger := logger.With(zap.String("request_id", id))
+	reqLogger.Info("request started")
+
+	named := reqLogger.Named("handler")
+	named.Warn("slow request")
+
+	go func() {
+		reqLogger.Info("request finished")
+	}()
+}
+
+func zapFieldsUnknown(fields []zap.Field, field zap.Field) {
+	logger := zap.L()
+	logger.Info("request started", fields...)
+	logger.Info("request started", field)
+}
+
+func sugared(id string) {
+	logger := zap.S()
+	logger.Infow("request started", "request_id", id)
+	logger.Infow("request started", "path", "/") // want `Info-level log call is missing required fields request_id`
+	logger.With("request_id", id).Infow("request started")
+	logger.Infof("request %s started", id) // want `Info-level log call is missing required fields request_id`
+}
+
+func slogAttrs(ctx context.Context, id string) {
+	logger := slog.Default()
+	logger.Info("request started", slog.String("request_id", id))
+	logger.Info("request started", "request_id", id)
+	logger.InfoContext(ctx, "request started", "request_id", id)
+	logger.InfoContext(ctx, "request started") // want `Info-level log call is missing required fields request_id`
+
+	reqLogger := logger.With("request_id", id)
+	reqLogger.Info("request started")
+}
+
+func logrusFields(id string) {
+	logger := logrus.New()
+	logger.WithField("request_id", id).Info("request started")
+	logger.WithFields(logrus.Fields{"request_id": id}).Info("request started")
+	logger.WithError(nil).Error("request failed") // want `Error-level log call is missing required fields request_id`
+	logger.Info("request started")                // want `Info-level log call is missing required fields request_id`
+}
+
+func globalLogger() {
+	slog.Info("request started") // want `Info-level log call is missing required fields request_id`
+}
+
+type handler struct {
+	logger *zap.Logger
+}
+
+func (h *handler) untraced(logger *zap.Logger, newLogger func() *zap.Logger) {
+	h.logger.Info("request started")                // want `Info-level log call is missing required fields request_id`
+	logger.Info("request started")                  // want `Info-level log call is missing required fields request_id`
+	logger.Named("handler").Info("request started") // want `Info-level log call is missing required fields request_id`
+	newLogger().Info("request started")             // want `Info-level log call is missing required fields request_id`
+	logger.Info("request started", zap.String("request_id", "1"))
+
+	fieldLogger := h.logger
+	fieldLogger.Info("request started") // want `Info-level log call is missing required fields request_id`
+}
+
+var requestLogger = zap.L().With(zap.String("request_id", "startup"))
+
+type requestHandler struct {
+	logger *zap.Logger
+}
+
+func newRequestHandler(id string) *requestHandler {
+	return &requestHandler{logger: zap.L().With(zap.String("request_id", id))}
+}
+
+func requestLoggerFor(id string) *zap.Logger {
+	return zap.L().With(zap.String("request_id", id))
+}
+
+func (h *requestHandler) builtWithFields(id string) {
+	h.logger.Info("request started")
+	requestLogger.Info("request started")
+	requestLoggerFor(id).Info("request started")
+
+	fieldLogger := h.logger
+	fieldLogger.Info("request started")
+}
@@ -0,0 +1,14 @@
+package billing
+
+import (
+	"go.uber.org/zap"
+)
+
+func charge(requestID, tenantID string, err error) {
+	logger := zap.L()
+	logger.Info("charging tenant")
+	logger.Error("charge failed", zap.Error(err))                                      // want `Error-level log call is missing required fields request_id, tenant_id`
+	logger.Error("charge failed", zap.Error(err), zap.String("request_id", requestID)) // want `Error-level log call is missing required fields tenant_id`
+	logger.With(zap.String("tenant_id", tenantID)).Error("charge failed", zap.String("request_id", requestID))
+	logger.Fatal("billing unavailable", zap.String("request_id", requestID), zap.String("tenant_id", tenantID))
+}
@@ -0,0 +1,62 @@
+package sensitiveargs
+
+import (
+	"log"
+	"time"
+
+	"example.com/redact"
+	"github.com/sirupsen/logrus"
+	"go.uber.org/zap"
+)
+
+type Secret string
+
+type user struct {
+	Name        string
+	Password    string
+	PasswordSet bool
+}
+
+type credentials struct {
+	ID  string
+	Key string
+}
+
+type config struct {
+	APIKey        Secret
+	PrivateKey    []byte
+	TokenTTL      int
+	ClientSecret  credentials
+	TokenExpiry   time.Time
+	TokenCount    int
+	PasswordRules []string
+	SecretName    string
+}
+
+const tokenHeader = "Authorization"
+
+func audit(format string, args ...interface{}) {}
+
+func printfArgs(sugar *zap.SugaredLogger, u *user, cfg config, token *string, tokens []string) {
+	sugar.Infof("login for %s with %s", u.Name, u.Password) // want `log argument u.Password is named after sensitive keyword "password"`
+	log.Printf("cfg: %v", cfg.APIKey)                       // want `log argument cfg.APIKey is named after sensitive keyword "api_key"`
+	logrus.Debugf("key %x loaded", cfg.PrivateKey)          // want `log argument cfg.PrivateKey is named after sensitive keyword "private_key"`
+	log.Printf("refreshing %s", *token)                     // want `log argument \*token is named after sensitive keyword "token"`
+	audit("auth header %s", (*token))                       // want `log argument \(\*token\) is named after sensitive keyword "token"`
+	log.Printf("loaded %v", tokens)                         // want `log argument tokens is named after sensitive keyword "token" \(medium confidence\)`
+	log.Printf("client %+v", cfg.ClientSecret)              // want `log argument cfg.ClientSecret is named after sensitive keyword "secret" \(medium confidence\)`
+
+	userToken := *token
+	log.Printf("session %s", userToken) // want `log argument userToken is named after sensitive keyword "token"`
+
+	log.Printf("password set: %t, token ttl: %d", u.PasswordSet, cfg.TokenTTL)
+	log.Printf("header %s", tokenHeader)
+	log.Printf("password type %T", u.Password)
+	log.Printf("login for %s with %s", u.Name, redact.String(u.Password))
+	log.Printf("first of %d", len(tokens))
+	log.Printf("%d tokens loaded", tokens)
+	log.Printf("token expires at %v", cfg.TokenExpiry)
+	log.Printf("%v tokens issued", cfg.TokenCount)
+	log.Printf("password rules: %v", cfg.PasswordRules)
+	log.Printf("reading secret %s", cfg.SecretName)
+}
@@ -0,0 +1,18 @@
+package sensitiveconfig
+
+import (
+	"log/slog"
+)
+
+func keywords() {
+	slog.Info("pwd changed")
+	slog.Info("session_id rotated") // want `log message contains sensitive keyword "session_id"`
+	slog.Info("sessionId rotated")  // want `log message contains sensitive keyword "session_id"`
+	slog.Info("password changed")   // want `log message contains sensitive keyword "password"`
+}
+
+func patterns() {
+	slog.Info("calling acme with acme_0123456789abcdef0123456789abcdef") // want `log message contains Acme API key`
+	slog.Info("request signed", "url", "/hook?sig=AbCdEf0123456789")     // want `log argument contains Acme signing secret`
+	slog.Info("calling acme with acme_0123")
+}
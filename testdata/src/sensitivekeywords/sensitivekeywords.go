@@ -0,0 +1,20 @@
+package sensitivekeywords
+
+import (
+	"log/slog"
+)
+
+func messages() {
+	slog.Info("tokenizer initialized")
+	slog.Info("passwordless login enabled")
+	slog.Info("secretary assigned")
+	slog.Info("pwd changed directory")
+	slog.Info("token bucket refilled")
+
+	slog.Info("refreshing access_token")         // want `log message contains sensitive keyword "token"`
+	slog.Info("userPassword rejected")           // want `log message contains sensitive keyword "password"`
+	slog.Info("sending x-api-key header")        // want `log message contains sensitive keyword "api_key"`
+	slog.Info("pwd reset requested")             // want `log message contains sensitive keyword "pwd"`
+	slog.Info("token bucket refilled, secret")   // want `log message contains sensitive keyword "secret"`
+	slog.Info("retrying with password: hunter2") // want `log message contains sensitive keyword "password"`
+}
@@ -0,0 +1,24 @@
+package spellcheck
+
+import (
+	"log/slog"
+
+	"go.uber.org/zap"
+)
+
+func messages(logger *zap.Logger, sugar *zap.SugaredLogger, addr string) {
+	logger.Error("conection refused")        // want `log message contains misspelled word "conection"; did you mean "connection"\?`
+	logger.Info("request recieved")          // want `log message contains misspelled word "recieved"; did you mean "received"\?`
+	logger.Warn("Conection lost, retrying")  // want `log message contains misspelled word "Conection"; did you mean "Connection"\?`
+	logger.Info("non-blocking wirte queued") // want `log message contains misspelled word "wirte"; did you mean "write"\?`
+	sugar.Infof("retreiving %s", addr)       // want `log message contains misspelled word "retreiving"; did you mean "retrieving"\?`
+	slog.Info("restarting kubelett")         // want `log message contains misspelled word "kubelett"; did you mean "kubelet"\?`
+
+	logger.Info("connection refused")
+	logger.Info("restarting kubelet for billing")
+	logger.Info("idempotency key reused")
+	logger.Info("userID not found in requestCtx")
+	logger.Info("retry_count exceeded for HTTPS upstream")
+	logger.Info("digest deadbeef01 mismatch at /var/lib/conection")
+	sugar.Infof("%s: %q queued", addr, addr)
+}
@@ -0,0 +1,14 @@
+package a
+
+import (
+	"log/slog"
+)
+
+const msgProcessFailed = "failed to process request"
+
+func Process(logger *slog.Logger) {
+	logger.Error(msgProcessFailed)              // ok
+	logger.Info("request processed")            // ok
+	logger.Warn("failed to process request")    // want `log message "failed to process request" is not unique, it is also used at uniqueness/a/a.go:10`
+	logger.Info("cache warmed up successfully") // ok
+}
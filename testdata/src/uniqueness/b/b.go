@@ -0,0 +1,15 @@
+package b
+
+import (
+	"log/slog"
+
+	"uniqueness/a"
+)
+
+func Handle(logger *slog.Logger, name string) {
+	a.Process(logger)
+	logger.Error("failed to process request")  // want `log message "failed to process request" is not unique, it is also used at uniqueness/a/a.go:10, uniqueness/a/a.go:12`
+	logger.Info("cache warmed up successfuly") // want `log message "cache warmed up successfuly" is nearly identical to "cache warmed up successfully" used at uniqueness/a/a.go:13`
+	logger.Info("handler registered")          // ok
+	logger.Info(name)                          // ok
+}
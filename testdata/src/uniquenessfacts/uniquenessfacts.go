@@ -0,0 +1,12 @@
+package uniquenessfacts // want package:`messages\(2\)`
+
+import (
+	"log/slog"
+)
+
+func Process(logger *slog.Logger, name string) {
+	logger.Info("request processed")
+	logger.Error("failed to process request")
+	logger.Error("failed to process request")
+	logger.Info(name)
+}